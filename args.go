@@ -40,6 +40,8 @@ const (
 	ArgAppWithProjects = "with-projects"
 	// ArgAppSpec is a path to an app spec.
 	ArgAppSpec = "spec"
+	// ArgAppMetric is the app metric to retrieve.
+	ArgAppMetric = "metric"
 	// ArgAppLogType the type of log.
 	ArgAppLogType = "type"
 	// ArgAppDeployment is the deployment ID.
@@ -56,18 +58,32 @@ const (
 	ArgAppLogFollow = "follow"
 	// ArgAppLogTail tail logs.
 	ArgAppLogTail = "tail"
+	// ArgAppLogNoReconnect disables reconnecting when a followed log stream drops.
+	ArgAppLogNoReconnect = "no-reconnect"
 	// ArgNoPrefix no prefix to json logs
 	ArgNoPrefix = "no-prefix"
 	// ArgAppForceRebuild forces a deployment rebuild
 	ArgAppForceRebuild = "force-rebuild"
 	// ArgAppComponents is a list of components to restart.
 	ArgAppComponents = "components"
+	// ArgAppConsoleNoStdin starts a read-only console session.
+	ArgAppConsoleNoStdin = "no-stdin"
+	// ArgAppCopyEnvFrom is the ID of an app whose environment variables are copied into a new app.
+	ArgAppCopyEnvFrom = "copy-env-from"
+	// ArgAppShowCost determines whether the proposed app's cost estimate is displayed.
+	ArgAppShowCost = "show-cost"
+	// ArgAppTier filters app instance sizes by tier.
+	ArgAppTier = "tier"
 	// ArgAppAlertDestinations is a path to an app alert destination file.
 	ArgAppAlertDestinations = "app-alert-destinations"
 	// ArgClusterName is a cluster name argument.
 	ArgClusterName = "cluster-name"
 	// ArgClusterVersionSlug is a cluster version argument.
 	ArgClusterVersionSlug = "version"
+	// ArgClusterWithUpgradeInfo adds upgrade availability to the cluster list.
+	ArgClusterWithUpgradeInfo = "with-upgrade-info"
+	// ArgClusterWithEvents adds the cluster's recent status messages to the output.
+	ArgClusterWithEvents = "with-events"
 	// ArgVPCUUID is a VPC UUID argument.
 	ArgVPCUUID = "vpc-uuid"
 	// ArgClusterVPCUUID is a cluster vpc-uuid argument.
@@ -92,6 +108,8 @@ const (
 	ArgNodePoolMinNodes = "min-nodes"
 	// ArgNodePoolMaxNodes is a cluster's node pool max_nodes argument.
 	ArgNodePoolMaxNodes = "max-nodes"
+	// ArgNodePoolDrainTimeout is the time to wait for each node of a node pool to drain before it is deleted.
+	ArgNodePoolDrainTimeout = "drain-timeout"
 	// ArgNodePoolNodeIDs is a cluster's node pool nodes argument.
 	ArgNodePoolNodeIDs = "node-ids"
 	// ArgMaintenanceWindow is a cluster's maintenance window argument
@@ -161,6 +179,10 @@ const (
 	ArgCommandUpdateSources = "update-sources"
 	// ArgCommandWait is a wait for a resource to be created argument.
 	ArgCommandWait = "wait"
+	// ArgWaitForHTTP is a URL to poll until it responds successfully.
+	ArgWaitForHTTP = "wait-for-http"
+	// ArgWaitForHTTPTimeout is how long to poll the --wait-for-http URL.
+	ArgWaitForHTTPTimeout = "wait-for-http-timeout"
 	// ArgSetCurrentContext is a flag to set the new kubeconfig context as current.
 	ArgSetCurrentContext = "set-current-context"
 	// ArgDropletID is a droplet id argument.
@@ -183,6 +205,16 @@ const (
 	ArgImageID = "image-id"
 	// ArgImagePublic is a public image argument.
 	ArgImagePublic = "public"
+	// ArgImagePrivate limits an image list to the images on the account.
+	ArgImagePrivate = "private"
+	// ArgImagePublicOnly limits an image list to public images.
+	ArgImagePublicOnly = "public-only"
+	// ArgImageDistribution filters images by distribution.
+	ArgImageDistribution = "distribution"
+	// ArgImageType filters images by type.
+	ArgImageType = "type"
+	// ArgImageMinSizeGB filters images by minimum size in GB.
+	ArgImageMinSizeGB = "min-size-gb"
 	// ArgImageSlug is an image slug argument.
 	ArgImageSlug = "image-slug"
 	// ArgInteractive is the argument to enable an interactive CLI.
@@ -215,8 +247,24 @@ const (
 	ArgPrivateNetworking = "enable-private-networking"
 	// ArgMonitoring is an enable monitoring argument.
 	ArgMonitoring = "enable-monitoring"
+	// ArgMetricsStart is the start of the time range for a metrics query.
+	ArgMetricsStart = "start"
+	// ArgMetricsEnd is the end of the time range for a metrics query.
+	ArgMetricsEnd = "end"
+	// ArgMetricsResolution is the interval metrics samples are averaged over.
+	ArgMetricsResolution = "resolution"
+	// ArgMetricsInterface is the network interface for bandwidth metrics.
+	ArgMetricsInterface = "interface"
+	// ArgDropletWithURLs adds the control panel URL of each Droplet to the output.
+	ArgDropletWithURLs = "with-urls"
+	// ArgDropletWithAgentInfo adds whether the metrics agent is reporting to the output.
+	ArgDropletWithAgentInfo = "with-agent-info"
+	// ArgDropletGenerateName is the prefix used to generate a unique Droplet name.
+	ArgDropletGenerateName = "generate-name"
 	// ArgDropletAgent is an argument for enabling/disabling the Droplet agent.
 	ArgDropletAgent = "droplet-agent"
+	// ArgDomainWithDetails determines whether record details should be fetched along with listed domains.
+	ArgDomainWithDetails = "with-details"
 	// ArgRecordData is a record data argument.
 	ArgRecordData = "record-data"
 	// ArgRecordID is a record id argument.
@@ -229,18 +277,44 @@ const (
 	ArgRecordPriority = "record-priority"
 	// ArgRecordType is a record type argument.
 	ArgRecordType = "record-type"
+	// ArgRecordDeleteAll is a flag for deleting all records of a domain that match a filter.
+	ArgRecordDeleteAll = "all"
 	// ArgRecordTTL is a record ttl argument.
 	ArgRecordTTL = "record-ttl"
 	// ArgRecordWeight is a record weight argument.
 	ArgRecordWeight = "record-weight"
 	// ArgRecordFlags is a record flags argument.
 	ArgRecordFlags = "record-flags"
+	// ArgRecordImportFile is the path of a file to import records from.
+	ArgRecordImportFile = "file"
+	// ArgRecordImportOverwrite replaces existing records with the same type and name when importing records.
+	ArgRecordImportOverwrite = "overwrite"
+	// ArgTerraformImport prints a terraform import command for created resources.
+	ArgTerraformImport = "tf-import"
+	// ArgDryRun prints the changes a command would make without making them.
+	ArgDryRun = "dry-run"
 	// ArgRecordTag is a record tag argument.
 	ArgRecordTag = "record-tag"
+	// ArgRecordMXPriority is the priority of an MX record.
+	ArgRecordMXPriority = "mx-priority"
+	// ArgRecordMXExchange is the mail exchange host of an MX record.
+	ArgRecordMXExchange = "mx-exchange"
+	// ArgRecordSRVPriority is the priority of an SRV record.
+	ArgRecordSRVPriority = "srv-priority"
+	// ArgRecordSRVWeight is the weight of an SRV record.
+	ArgRecordSRVWeight = "srv-weight"
+	// ArgRecordSRVPort is the port of an SRV record.
+	ArgRecordSRVPort = "srv-port"
+	// ArgRecordSRVTarget is the target host of an SRV record.
+	ArgRecordSRVTarget = "srv-target"
+	// ArgRecordDataContains filters records by a substring of their data.
+	ArgRecordDataContains = "data-contains"
 	// ArgRegionSlug is a region slug argument.
 	ArgRegionSlug = "region"
 	// ArgSchemaOnly is a schema only argument.
 	ArgSchemaOnly = "schema-only"
+	// ArgOnlineValidate is an argument that enables checking an app spec's references against the API.
+	ArgOnlineValidate = "online-validate"
 	// ArgSizeSlug is a size slug argument.
 	ArgSizeSlug = "size"
 	// ArgSizeUnit is a size unit argument.
@@ -263,6 +337,8 @@ const (
 	ArgUserData = "user-data"
 	// ArgUserDataFile is a user data file location argument.
 	ArgUserDataFile = "user-data-file"
+	// ArgCloudInitAddPackage is a package to install on first boot via cloud-init.
+	ArgCloudInitAddPackage = "cloud-init-add-package"
 	// ArgImageName name is an image name argument.
 	ArgImageName = "image-name"
 	// ArgImageExternalURL is a URL that returns an image file.
@@ -347,12 +423,22 @@ const (
 	ArgVolumeRegion = "region"
 	// ArgVolumeSnapshot is the snapshot from which to create a volume.
 	ArgVolumeSnapshot = "snapshot"
+	// ArgVolumeSnapshotID is the ID of the snapshot from which to create a volume.
+	ArgVolumeSnapshotID = "snapshot-id"
 	// ArgVolumeFilesystemType is the filesystem type for a volume.
 	ArgVolumeFilesystemType = "fs-type"
 	// ArgVolumeFilesystemLabel is the filesystem label for a volume.
 	ArgVolumeFilesystemLabel = "fs-label"
 	// ArgVolumeList is the IDs of many volumes.
 	ArgVolumeList = "volumes"
+	// ArgLimit is the maximum number of items to display.
+	ArgLimit = "limit"
+	// ArgSortBy is the field to sort a list by.
+	ArgSortBy = "sort-by"
+	// ArgResolveNames determines whether resource IDs should be resolved to their names for display.
+	ArgResolveNames = "resolve-names"
+	// ArgVolumeID is the ID of a volume.
+	ArgVolumeID = "volume-id"
 	// ArgVolumeSnapshotList is the IDs of many volume snapshots.
 	ArgVolumeSnapshotList = "snapshots"
 	// ArgLoadBalancerList is the IDs of many load balancers.
@@ -379,9 +465,15 @@ const (
 	ArgCertificateChainPath = "certificate-chain-path"
 	// ArgCertificateType is a certificate type.
 	ArgCertificateType = "type"
+	// ArgExpiringWithin limits a list to resources that expire within a duration.
+	ArgExpiringWithin = "expiring-within"
 
 	// ArgLoadBalancerName is a name of the load balancer.
 	ArgLoadBalancerName = "name"
+	// ArgLoadBalancerGroupByRegion groups the load balancer list by region.
+	ArgLoadBalancerGroupByRegion = "group-by-region"
+	// ArgLoadBalancerCheckHealth adds a health summary to the load balancer list.
+	ArgLoadBalancerCheckHealth = "check-health"
 	// ArgLoadBalancerAlgorithm is a load balancing algorithm.
 	ArgLoadBalancerAlgorithm = "algorithm"
 	// ArgRedirectHTTPToHTTPS is a flag that indicates whether HTTP requests to the load balancer on port 80 should be redirected to HTTPS on port 443.
@@ -420,6 +512,16 @@ const (
 	ArgLoadBalancerNetworkStack = "network-stack"
 	// ArgLoadBalancerTLSCipherPolicy is the tls cipher policy to be used for the load balancer
 	ArgLoadBalancerTLSCipherPolicy = "tls-cipher-policy"
+	// ArgLoadBalancerPatchFile is the path to a JSON merge patch to apply to a load balancer.
+	ArgLoadBalancerPatchFile = "patch-file"
+	// ArgLoadBalancerBackend is the ID of a Droplet behind a load balancer.
+	ArgLoadBalancerBackend = "backend"
+	// ArgStickySessionsType is the sticky sessions type of a load balancer.
+	ArgStickySessionsType = "type"
+	// ArgStickySessionsCookieName is the name of the sticky sessions cookie.
+	ArgStickySessionsCookieName = "cookie-name"
+	// ArgStickySessionsCookieTTL is the lifetime of the sticky sessions cookie in seconds.
+	ArgStickySessionsCookieTTL = "cookie-ttl-seconds"
 
 	// ArgFirewallName is a name of the firewall.
 	ArgFirewallName = "name"
@@ -524,6 +626,8 @@ const (
 	ArgDatabaseTopicSegmentJitterMS = "segment-jitter-ms"
 	// ArgDatabaseTopicSegmentMS is the period of time, in ms, after which the log will be forced to roll if the segment file isn't full
 	ArgDatabaseTopicSegmentMS = "segment-ms"
+	// ArgDatabaseTopicConfig is a list of key=value pairs of advanced configuration for a kafka topic
+	ArgDatabaseTopicConfig = "topic-config"
 
 	// ArgPrivateNetworkUUID is the flag for VPC UUID
 	ArgPrivateNetworkUUID = "private-network-uuid"
@@ -542,6 +646,8 @@ const (
 	ArgVPCDescription = "description"
 	// ArgVPCDefault is the VPC default argument, to update a specific VPC to the default VPC.
 	ArgVPCDefault = "default"
+	// ArgVPCMemberType filters VPC members by resource type.
+	ArgVPCMemberType = "type"
 	// ArgVPCIPRange is a VPC range of IP addresses in CIDR notation.
 	ArgVPCIPRange = "ip-range"
 
@@ -612,6 +718,12 @@ const (
 	//ArgDangerous indicates whether to delete the cluster and all it's associated resources
 	ArgDangerous = "dangerous"
 
+	// ArgCascade indicates whether to delete the load balancers and volumes associated with a cluster
+	ArgCascade = "cascade"
+
+	// ArgDestroyAssociatedResources is an alias of ArgDangerous
+	ArgDestroyAssociatedResources = "destroy-associated-resources"
+
 	// ArgDatabaseFirewallRule the firewall rules.
 	ArgDatabaseFirewallRule = "rule"
 
@@ -24,17 +24,24 @@ import (
 	"net/url"
 	"os"
 	"os/signal"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/digitalocean/doctl"
+	"github.com/digitalocean/doctl/commands/charm/selection"
 	"github.com/digitalocean/doctl/commands/displayers"
 	"github.com/digitalocean/doctl/do"
 	"github.com/digitalocean/doctl/internal/apps"
+	"github.com/digitalocean/doctl/pkg/listen"
 	"github.com/digitalocean/doctl/pkg/terminal"
 	"github.com/digitalocean/godo"
+	"github.com/digitalocean/godo/metrics"
 	"github.com/google/uuid"
 	multierror "github.com/hashicorp/go-multierror"
+	"github.com/pmezard/go-difflib/difflib"
 	"github.com/spf13/cobra"
 	"golang.org/x/sync/errgroup"
 	"sigs.k8s.io/yaml"
@@ -59,7 +66,9 @@ func Apps() *Command {
 		RunAppsCreate,
 		"create",
 		"Create an app",
-		`Create an app with the given app spec. To create an app, App Platform must have access to your repository service. Click one of the following links to provide access for your preferred service: [GitHub](https://cloud.digitalocean.com/apps/gitlab/install), [GitLab](https://cloud.digitalocean.com/apps/gitlab/install), [BitBucket](https://cloud.digitalocean.com/apps/bitbucket/install)`,
+		`Create an app with the given app spec. To create an app, App Platform must have access to your repository service. Click one of the following links to provide access for your preferred service: [GitHub](https://cloud.digitalocean.com/apps/gitlab/install), [GitLab](https://cloud.digitalocean.com/apps/gitlab/install), [BitBucket](https://cloud.digitalocean.com/apps/bitbucket/install)
+
+The spec is validated with App Platform before the app is created, so problems such as a source repository that App Platform cannot access are reported without creating the app.`,
 		Writer,
 		aliasOpt("c"),
 		displayerType(&displayers.Apps{}),
@@ -70,6 +79,7 @@ func Apps() *Command {
 	AddBoolFlag(create, doctl.ArgCommandUpsert, "", false, `A boolean value that creates or updates an app’s configuration with the attached app spec. This does not pull changes from the app’s container registry or source repository. Instead, App Platform uses the image from the app’s most recent deployment. To additionally pull the latest changes from the app’s source, set the `+"`"+`--update-sources`+"`"+` flag.`)
 	AddBoolFlag(create, doctl.ArgCommandUpdateSources, "", false, "Boolean that specifies whether, on update, the app should also update its source code")
 	AddStringFlag(create, doctl.ArgProjectID, "", "", "The ID of the project to assign the created app and resources to. If not provided, the default project will be used.")
+	AddStringFlag(create, doctl.ArgAppCopyEnvFrom, "", "", "The ID of an existing app to copy environment variables from. App-level variables and the variables of components with the same name are merged into the spec; variables already set in the spec are kept. Secret values cannot be copied and must be re-entered.")
 	create.Example = `The following example creates an app in a project named ` + "`" + `example-project` + "`" + ` using an app spec located in a directory called ` + "`" + `/src/your-app.yaml` + "`" + `. Additionally, the command returns the new app's ID, ingress information, and creation date: doctl apps create --spec src/your-app.yaml --format ID,DefaultIngress,Created`
 
 	CmdBuilder(
@@ -105,7 +115,9 @@ Only basic information is included with the text output format. For complete app
 		RunAppsUpdate,
 		"update <app id>",
 		"Updates an app",
-		`Updates an existing app with the attached app spec. By default, this does not retrieve the latest image from the app’s container registry or changes source repository. To deploy an app with changes from its source repository and app spec configuration, use the `+"`"+`--update-sources`+"`"+` flag. For more information about app specs, see the [app spec reference](https://www.digitalocean.com/docs/app-platform/concepts/app-spec)`,
+		`Updates an existing app with the attached app spec. By default, this does not retrieve the latest image from the app’s container registry or changes source repository. To deploy an app with changes from its source repository and app spec configuration, use the `+"`"+`--update-sources`+"`"+` flag. For more information about app specs, see the [app spec reference](https://www.digitalocean.com/docs/app-platform/concepts/app-spec)
+
+If the app spec applied by App Platform differs from the submitted spec, for example because default values were added, the differences are printed to stderr.`,
 		Writer,
 		aliasOpt("u"),
 		displayerType(&displayers.Apps{}),
@@ -175,7 +187,7 @@ Only basic information is included with the text output format. For complete app
 	)
 	getDeployment.Example = `The following example gets information about a deployment with the ID ` + "`" + `418b7972-fc67-41ea-ab4b-6f9477c4f7d8` + "`" + ` for an app with the ID ` + "`" + `f81d4fae-7dec-11d0-a765-00a0c91e6bf6` + "`" + `. Additionally, the command returns the deployment's ID, status, and cause: doctl apps get-deployment f81d4fae-7dec-11d0-a765-00a0c91e6bf6 418b7972-fc67-41ea-ab4b-6f9477c4f7d8 --format ID,Status,Cause`
 
-	CmdBuilder(
+	listDeployments := CmdBuilder(
 		cmd,
 		RunAppsListDeployments,
 		"list-deployments <app id>",
@@ -187,6 +199,8 @@ Only basic information is included with the text output format. For complete app
 		aliasOpt("lsd"),
 		displayerType(&displayers.Deployments{}),
 	)
+	AddIntFlag(listDeployments, doctl.ArgLimit, "", 0, "Only show the N most recent deployments")
+	listDeployments.Example = `The following example lists the five most recent deployments of an app with the ID ` + "`" + `f81d4fae-7dec-11d0-a765-00a0c91e6bf6` + "`" + `: doctl apps list-deployments f81d4fae-7dec-11d0-a765-00a0c91e6bf6 --limit 5`
 
 	logs := CmdBuilder(
 		cmd,
@@ -201,18 +215,43 @@ Three types of logs are supported and can be specified with the --`+doctl.ArgApp
 - run
 - run_restarted
 
+If no component name is given for run logs, the app's only service, worker, or job is used. When the app has several, you are prompted to choose one in interactive mode; otherwise logs for all components are retrieved.
+
+When following logs with the --`+doctl.ArgAppLogFollow+` flag, a dropped connection is retried up to `+strconv.Itoa(appLogsMaxReconnects)+` consecutive times with exponential backoff, and a notice is printed to stderr once the stream resumes. Use the --`+doctl.ArgAppLogNoReconnect+` flag to exit on the first disconnect instead.
+
 For more information about logs, see [How to View Logs](https://www.digitalocean.com/docs/app-platform/how-to/view-logs/).
 `,
 		Writer,
 		aliasOpt("l"),
 	)
-	AddStringFlag(logs, doctl.ArgAppDeployment, "", "", "Retrieves logs for a specific deployment ID. Defaults to current deployment.")
+	AddStringFlag(logs, doctl.ArgAppDeployment, "", "", "Retrieves logs for a specific deployment ID, including past deployments that are no longer active. Defaults to current deployment.")
 	AddStringFlag(logs, doctl.ArgAppLogType, "", strings.ToLower(string(godo.AppLogTypeRun)), "Retrieves logs for a specific log type. Defaults to run logs.")
 	AddBoolFlag(logs, doctl.ArgAppLogFollow, "f", false, "Returns logs as they are emitted by the app.")
 	AddIntFlag(logs, doctl.ArgAppLogTail, "", -1, "Specifies the number of lines to show from the end of the log.")
+	AddBoolFlag(logs, doctl.ArgAppLogNoReconnect, "", false, "Exits when a followed log stream disconnects instead of reconnecting.")
 	AddBoolFlag(logs, doctl.ArgNoPrefix, "", false, "Removes the prefix from logs. Useful for JSON structured logs")
 
-	logs.Example = `The following example retrieves the build logs for the app with the ID ` + "`" + `f81d4fae-7dec-11d0-a765-00a0c91e6bf6` + "`" + ` and the component ` + "`" + `web` + "`" + `: doctl apps logs f81d4fae-7dec-11d0-a765-00a0c91e6bf6 web --type build`
+	logs.Example = `The following example retrieves the build logs for the app with the ID ` + "`" + `f81d4fae-7dec-11d0-a765-00a0c91e6bf6` + "`" + ` and the component ` + "`" + `web` + "`" + `: doctl apps logs f81d4fae-7dec-11d0-a765-00a0c91e6bf6 web --type build` + "\n\nThe following example retrieves the build logs for a previous deployment of the same app: doctl apps logs f81d4fae-7dec-11d0-a765-00a0c91e6bf6 web --type build --deployment 3aa4d20e-5527-4f3e-a3c5-94e1a2e2b5b0"
+
+	appMetrics := CmdBuilder(
+		cmd,
+		RunAppsMetrics,
+		"metrics <app name or id> <component name>",
+		"Retrieves component metrics",
+		`Retrieves a time series of metrics for a component of an app. The metric is selected with the --`+doctl.ArgAppMetric+` flag:
+- cpu: the average CPU utilization of the component's instances, as a percentage
+- memory: the average memory utilization of the component's instances, as a percentage
+- restarts: the total number of instance restarts
+
+Use `+"`"+`--output json`+"`"+` to feed the time series into external monitoring tools.`,
+		Writer,
+		aliasOpt("m"),
+		displayerType(&displayers.Metrics{}),
+	)
+	AddStringFlag(appMetrics, doctl.ArgAppMetric, "", "cpu", "The metric to retrieve. Possible values: `cpu`, `memory`, `restarts`. HTTP request counts are not available, as the monitoring API only exposes CPU, memory and restart metrics for app components.")
+	addMetricsTimeRangeFlags(appMetrics)
+	addMetricsResolutionFlag(appMetrics)
+	appMetrics.Example = `The following example retrieves the memory utilization of the ` + "`" + `web` + "`" + ` component of an app with the ID ` + "`" + `f81d4fae-7dec-11d0-a765-00a0c91e6bf6` + "`" + ` over the last hour: doctl apps metrics f81d4fae-7dec-11d0-a765-00a0c91e6bf6 web --metric memory`
 
 	console := CmdBuilder(
 		cmd,
@@ -225,6 +264,7 @@ For more information about logs, see [How to View Logs](https://www.digitalocean
 	)
 	AddStringFlag(console, doctl.ArgAppDeployment, "", "", "Starts a console session for a specific deployment ID. Defaults to current deployment.")
 	AddStringFlag(console, doctl.ArgAppInstanceName, "", "", "Starts a console session for a specific instance name. Optional, defaults to the first available instance. For apps with multiple instances, you can specify the instance name to start the console session for that particular instance.")
+	AddBoolFlag(console, doctl.ArgAppConsoleNoStdin, "", false, "Starts a read-only console session. The output of the console is streamed to your terminal, but no keystrokes are sent to the instance.")
 
 	console.Example = `The following example initiates a console session for the app with the ID ` + "`" + `f81d4fae-7dec-11d0-a765-00a0c91e6bf6` + "`" + ` and the component ` + "`" + `web` + "`" + `: doctl apps console f81d4fae-7dec-11d0-a765-00a0c91e6bf6 web. To initiate a console session to a specific instance, append the instance id: doctl apps console f81d4fae-7dec-11d0-a765-00a0c91e6bf6 web sample-golang-5d9f95556c-5f58g`
 
@@ -245,7 +285,7 @@ For more information about logs, see [How to View Logs](https://www.digitalocean
 		RunAppsListRegions,
 		"list-regions",
 		"Lists available App Platform regions",
-		`Lists all regions supported by App Platform, including details about their current availability.`,
+		`Lists all regions supported by App Platform, including details about their current availability. The default region for new apps is listed first, followed by the remaining regions in alphabetical order.`,
 		Writer,
 		displayerType(&displayers.AppRegions{}),
 	)
@@ -265,6 +305,7 @@ Only basic information is included with the text output format. For complete app
 	)
 	AddStringFlag(propose, doctl.ArgAppSpec, "", "", "Path to an app spec in JSON or YAML format. For more information about app specs, see the [app spec reference](https://www.digitalocean.com/docs/app-platform/concepts/app-spec)", requiredOpt())
 	AddStringFlag(propose, doctl.ArgApp, "", "", "An optional existing app ID. If specified, App Platform treats the spec as a proposed update to the existing app.")
+	AddBoolFlag(propose, doctl.ArgAppShowCost, "", true, "Includes the app's estimated monthly cost in the output. Set `--show-cost=false` to omit it.")
 	propose.Example = `The following example proposes an app spec from the file directory ` + "`" + `src/your-app.yaml` + "`" + ` for a new app: doctl apps propose --spec src/your-app.yaml`
 
 	listAlerts := CmdBuilder(
@@ -353,6 +394,27 @@ func RunAppsCreate(c *CmdConfig) error {
 		return err
 	}
 
+	copyEnvFrom, err := c.Doit.GetString(c.NS, doctl.ArgAppCopyEnvFrom)
+	if err != nil {
+		return err
+	}
+	if copyEnvFrom != "" {
+		source, err := c.Apps().Get(copyEnvFrom)
+		if err != nil {
+			return err
+		}
+		if secrets := copyAppEnvs(appSpec, source.Spec); len(secrets) > 0 {
+			warn("The values of secret environment variables cannot be copied and must be re-entered: %s", strings.Join(secrets, ", "))
+		}
+	}
+
+	// Proposing the spec first reports problems such as a source repository
+	// that cannot be accessed before anything is created.
+	_, err = c.Apps().Propose(&godo.AppProposeRequest{Spec: appSpec})
+	if err != nil {
+		return err
+	}
+
 	app, err := c.Apps().Create(&godo.AppCreateRequest{Spec: appSpec, ProjectID: projectID})
 	if err != nil {
 		if gerr, ok := err.(*godo.ErrorResponse); ok && gerr.Response.StatusCode == 409 && upsert {
@@ -403,6 +465,68 @@ func RunAppsCreate(c *CmdConfig) error {
 	return c.Display(displayers.Apps{app})
 }
 
+// appComponentEnvs returns the environment variables of an app component, or
+// nil if the component type has none.
+func appComponentEnvs(component godo.AppComponentSpec) *[]*godo.AppVariableDefinition {
+	switch c := component.(type) {
+	case *godo.AppServiceSpec:
+		return &c.Envs
+	case *godo.AppWorkerSpec:
+		return &c.Envs
+	case *godo.AppJobSpec:
+		return &c.Envs
+	case *godo.AppStaticSiteSpec:
+		return &c.Envs
+	case *godo.AppFunctionsSpec:
+		return &c.Envs
+	}
+	return nil
+}
+
+// copyAppEnvs merges the app-level environment variables of src, and those of
+// its components, into dst. Component variables are copied to the component
+// of dst with the same name. Variables already defined in dst are kept. Secret
+// values are encrypted for the source app, so secrets are copied without a
+// value and their keys are returned.
+func copyAppEnvs(dst, src *godo.AppSpec) []string {
+	var secrets []string
+	merge := func(to *[]*godo.AppVariableDefinition, from []*godo.AppVariableDefinition, prefix string) {
+		defined := make(map[string]bool, len(*to))
+		for _, env := range *to {
+			defined[env.Key] = true
+		}
+		for _, env := range from {
+			if defined[env.Key] {
+				continue
+			}
+			copied := *env
+			if copied.Type == godo.AppVariableType_Secret {
+				copied.Value = ""
+				secrets = append(secrets, prefix+copied.Key)
+			}
+			*to = append(*to, &copied)
+		}
+	}
+
+	merge(&dst.Envs, src.Envs, "")
+
+	srcEnvs := make(map[string][]*godo.AppVariableDefinition)
+	_ = src.ForEachAppComponentSpec(func(component godo.AppComponentSpec) error {
+		if envs := appComponentEnvs(component); envs != nil {
+			srcEnvs[component.GetName()] = *envs
+		}
+		return nil
+	})
+	_ = dst.ForEachAppComponentSpec(func(component godo.AppComponentSpec) error {
+		if envs := appComponentEnvs(component); envs != nil {
+			merge(envs, srcEnvs[component.GetName()], component.GetName()+"/")
+		}
+		return nil
+	})
+
+	return secrets
+}
+
 // RunAppsGet gets an app.
 func RunAppsGet(c *CmdConfig) error {
 	if len(c.Args) < 1 {
@@ -483,9 +607,40 @@ func RunAppsUpdate(c *CmdConfig) error {
 
 	notice("App updated")
 
+	diff, err := appSpecDiff(appSpec, app.Spec)
+	if err != nil {
+		return err
+	}
+	if diff != "" {
+		notice("The applied app spec differs from the submitted spec:")
+		fmt.Fprint(os.Stderr, diff)
+	}
+
 	return c.Display(displayers.Apps{app})
 }
 
+// appSpecDiff returns a unified diff between the submitted app spec and the
+// spec returned by the API, which may have normalized or added fields. It
+// returns an empty string when the specs are identical.
+func appSpecDiff(submitted, applied *godo.AppSpec) (string, error) {
+	a, err := yaml.Marshal(submitted)
+	if err != nil {
+		return "", err
+	}
+	b, err := yaml.Marshal(applied)
+	if err != nil {
+		return "", err
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(a)),
+		B:        difflib.SplitLines(string(b)),
+		FromFile: "submitted",
+		ToFile:   "applied",
+		Context:  3,
+	})
+}
+
 // RunAppsDelete deletes an app.
 func RunAppsDelete(c *CmdConfig) error {
 	if len(c.Args) < 1 {
@@ -651,14 +806,148 @@ func RunAppsListDeployments(c *CmdConfig) error {
 	}
 	appID := c.Args[0]
 
+	limit, err := c.Doit.GetInt(c.NS, doctl.ArgLimit)
+	if err != nil {
+		return err
+	}
+	if limit < 0 {
+		return fmt.Errorf("--%s must be a positive number", doctl.ArgLimit)
+	}
+
 	deployments, err := c.Apps().ListDeployments(appID)
 	if err != nil {
 		return err
 	}
 
+	if limit > 0 {
+		sort.SliceStable(deployments, func(i, j int) bool {
+			return deployments[i].CreatedAt.After(deployments[j].CreatedAt)
+		})
+		if len(deployments) > limit {
+			deployments = deployments[:limit]
+		}
+	}
+
 	return c.Display(displayers.Deployments(deployments))
 }
 
+// appMetricKinds maps the values of the --metric flag to the monitoring API
+// metric and the column it is displayed in.
+var appMetricKinds = map[string]struct {
+	endpoint string
+	column   string
+	sum      bool
+}{
+	"cpu":      {endpoint: "cpu_percentage", column: "CPU"},
+	"memory":   {endpoint: "memory_percentage", column: "Memory"},
+	"restarts": {endpoint: "restart_count", column: "Restarts", sum: true},
+}
+
+// RunAppsMetrics retrieves a metric time series for an app component.
+func RunAppsMetrics(c *CmdConfig) error {
+	if len(c.Args) < 2 {
+		return doctl.NewMissingArgsErr(c.NS)
+	}
+	appID, component := c.Args[0], c.Args[1]
+
+	metricName, err := c.Doit.GetString(c.NS, doctl.ArgAppMetric)
+	if err != nil {
+		return err
+	}
+	if metricName == "http_requests" {
+		return fmt.Errorf("the monitoring API does not expose HTTP request metrics for app components; possible values for --%s: cpu, memory, restarts", doctl.ArgAppMetric)
+	}
+	metric, ok := appMetricKinds[metricName]
+	if !ok {
+		return fmt.Errorf("invalid value %q for --%s; possible values: cpu, memory, restarts", metricName, doctl.ArgAppMetric)
+	}
+
+	start, end, err := metricsTimeRange(c)
+	if err != nil {
+		return err
+	}
+
+	resolution, err := c.Doit.GetDuration(c.NS, doctl.ArgMetricsResolution)
+	if err != nil {
+		return err
+	}
+
+	if _, err := uuid.Parse(appID); err != nil {
+		app, err := c.Apps().Find(appID)
+		if err != nil {
+			return err
+		}
+		appID = app.ID
+	}
+
+	resp, err := c.Monitoring().GetAppMetrics(appID, component, metric.endpoint, start, end)
+	if err != nil {
+		return err
+	}
+
+	// Each instance of the component reports its own stream.
+	samples := meanMetricStreams(resp.Data.Result)
+	if metric.sum {
+		samples = sumMetricStreams(resp.Data.Result)
+	}
+
+	item := &displayers.Metrics{
+		Series: []string{metric.column},
+		Points: resampleMetricPoints(metricPoints(map[string][]metrics.SamplePair{metric.column: samples}), resolution),
+	}
+	return c.Display(item)
+}
+
+// pickAppLogComponent chooses the component to retrieve run logs for when none
+// was given. A single component with run logs is selected automatically, and
+// an interactive session is prompted to choose between several. Otherwise an
+// empty name is returned so that logs for all components are retrieved.
+func pickAppLogComponent(app *godo.App, logType string) (string, error) {
+	var names []string
+	_ = godo.ForEachAppSpecComponent(app.GetSpec(), func(component godo.AppContainerComponentSpec) error {
+		names = append(names, component.GetName())
+		return nil
+	})
+
+	switch {
+	case len(names) == 0:
+		return "", fmt.Errorf("app %s has no components that produce %s logs", app.GetSpec().GetName(), logType)
+	case len(names) == 1:
+		notice("Showing %s logs for component %s", logType, names[0])
+		return names[0], nil
+	case Interactive:
+		sel := selection.New(names, selection.WithPrompt("select a component:"))
+		return sel.Select()
+	default:
+		return "", nil
+	}
+}
+
+const appLogsMaxReconnects = 5
+
+// appLogsReconnectBackoff is the delay before the first attempt to reconnect
+// to a live log stream. It doubles with each further attempt.
+var appLogsReconnectBackoff = time.Second
+
+// listenAppLogs streams logs from a live log URL until the connection closes.
+func listenAppLogs(ctx context.Context, c *CmdConfig, liveURL string, schemaFunc listen.SchemaFunc) error {
+	url, err := url.Parse(liveURL)
+	if err != nil {
+		return err
+	}
+
+	token := url.Query().Get("token")
+	switch url.Scheme {
+	case "http":
+		url.Scheme = "ws"
+	default:
+		url.Scheme = "wss"
+	}
+
+	listener := c.Doit.Listen(url, token, schemaFunc, c.Out, nil)
+	return listener.Listen(ctx)
+}
+
 // RunAppsGetLogs gets app logs for a given component.
 func RunAppsGetLogs(c *CmdConfig) error {
 	if len(c.Args) < 1 {
@@ -675,9 +964,10 @@ func RunAppsGetLogs(c *CmdConfig) error {
 		return err
 	}
 
+	var app *godo.App
 	_, err = uuid.Parse(appID)
 	if err != nil || deploymentID == "" {
-		app, err := c.Apps().Find(appID)
+		app, err = c.Apps().Find(appID)
 		if err != nil {
 			return err
 		}
@@ -712,6 +1002,20 @@ func RunAppsGetLogs(c *CmdConfig) error {
 	default:
 		return fmt.Errorf("Invalid log type %s", logTypeStr)
 	}
+
+	if component == "" && (logType == godo.AppLogTypeRun || logType == godo.AppLogTypeRunRestarted) {
+		if app == nil {
+			app, err = c.Apps().Get(appID)
+			if err != nil {
+				return err
+			}
+		}
+		component, err = pickAppLogComponent(app, logTypeStr)
+		if err != nil {
+			return err
+		}
+	}
+
 	logFollow, err := c.Doit.GetBool(c.NS, doctl.ArgAppLogFollow)
 	if err != nil {
 		return err
@@ -726,22 +1030,22 @@ func RunAppsGetLogs(c *CmdConfig) error {
 		return err
 	}
 
+	noReconnect, err := c.Doit.GetBool(c.NS, doctl.ArgAppLogNoReconnect)
+	if err != nil {
+		return err
+	}
+
 	logs, err := c.Apps().GetLogs(appID, deploymentID, component, logType, logFollow, logTail)
 	if err != nil {
 		return err
 	}
 
 	if logs.LiveURL != "" {
-		url, err := url.Parse(logs.LiveURL)
-		if err != nil {
-			return err
-		}
-
 		schemaFunc := func(message []byte) (io.Reader, error) {
 			data := struct {
 				Data string `json:"data"`
 			}{}
-			err = json.Unmarshal(message, &data)
+			err := json.Unmarshal(message, &data)
 			if err != nil {
 				return nil, err
 			}
@@ -762,18 +1066,45 @@ func RunAppsGetLogs(c *CmdConfig) error {
 			return r, nil
 		}
 
-		token := url.Query().Get("token")
-		switch url.Scheme {
-		case "http":
-			url.Scheme = "ws"
-		default:
-			url.Scheme = "wss"
-		}
-
-		listener := c.Doit.Listen(url, token, schemaFunc, c.Out, nil)
 		ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
 		defer cancel()
-		err = listener.Listen(ctx)
+
+		err = listenAppLogs(ctx, c, logs.LiveURL, schemaFunc)
+		if !logFollow || noReconnect {
+			return err
+		}
+
+		// The live URL carries a short-lived token, so a fresh one is requested
+		// for each attempt. Tailing is skipped to avoid repeating lines.
+		for attempt := 0; err != nil && attempt < appLogsMaxReconnects; attempt++ {
+			select {
+			case <-ctx.Done():
+				return err
+			case <-time.After(appLogsReconnectBackoff << attempt):
+			}
+
+			logs, err = c.Apps().GetLogs(appID, deploymentID, component, logType, logFollow, 0)
+			if err != nil {
+				continue
+			}
+			if logs.LiveURL == "" {
+				return fmt.Errorf("unable to reconnect; no live log stream available for app %s", appID)
+			}
+
+			var resumed bool
+			err = listenAppLogs(ctx, c, logs.LiveURL, func(message []byte) (io.Reader, error) {
+				if !resumed {
+					resumed = true
+					notice("Reconnected to the log stream")
+				}
+				return schemaFunc(message)
+			})
+			// Only consecutive failed attempts count towards the limit, so a
+			// long-running stream can survive any number of separate drops.
+			if resumed {
+				attempt = -1
+			}
+		}
 		if err != nil {
 			return err
 		}
@@ -822,6 +1153,11 @@ func RunAppsConsole(c *CmdConfig) error {
 		return err
 	}
 
+	noStdin, err := c.Doit.GetBool(c.NS, doctl.ArgAppConsoleNoStdin)
+	if err != nil {
+		return err
+	}
+
 	opts := &godo.AppGetExecOptions{
 		DeploymentID: deploymentID,
 		InstanceName: instanceName,
@@ -859,12 +1195,17 @@ func RunAppsConsole(c *CmdConfig) error {
 	grp, ctx := errgroup.WithContext(ctx)
 
 	term := c.Doit.Terminal()
-	stdinCh := make(chan string)
-	restoreTerminal, err := term.ReadRawStdin(ctx, stdinCh)
-	if err != nil {
-		return err
+	// With --no-stdin, stdinCh is left nil so that no keystrokes are ever
+	// sent. Keepalive and resize events are still sent.
+	var stdinCh chan string
+	if !noStdin {
+		stdinCh = make(chan string)
+		restoreTerminal, err := term.ReadRawStdin(ctx, stdinCh)
+		if err != nil {
+			return err
+		}
+		defer restoreTerminal()
 	}
-	defer restoreTerminal()
 
 	resizeEvents := make(chan terminal.TerminalSize)
 	grp.Go(func() error {
@@ -952,7 +1293,12 @@ func RunAppsPropose(c *CmdConfig) error {
 		return err
 	}
 
-	return c.Display(displayers.AppProposeResponse{Res: res})
+	showCost, err := c.Doit.GetBool(c.NS, doctl.ArgAppShowCost)
+	if err != nil {
+		return err
+	}
+
+	return c.Display(displayers.AppProposeResponse{Res: res, HideCost: !showCost})
 }
 
 func appsSpec() *Command {
@@ -966,7 +1312,9 @@ func appsSpec() *Command {
 
 	getCmd := CmdBuilder(cmd, RunAppsSpecGet, "get <app id>", "Retrieve an application's spec", `Use this command to retrieve the latest spec of an app.
 
-Optionally, pass a deployment ID to get the spec of that specific deployment.`, Writer)
+Optionally, pass a deployment ID to get the spec of that specific deployment.
+
+The spec is printed as YAML by default. Use `+"`"+`--format json`+"`"+` to print it as JSON instead.`, Writer)
 	AddStringFlag(getCmd, doctl.ArgAppDeployment, "", "", "optional: a deployment ID")
 	AddStringFlag(getCmd, doctl.ArgFormat, "", "yaml", `the format to output the spec in; either "yaml" or "json"`)
 
@@ -974,6 +1322,8 @@ Optionally, pass a deployment ID to get the spec of that specific deployment.`,
 
 You may pass - as the filename to read from stdin.`, Writer, false)
 	AddBoolFlag(validateCmd, doctl.ArgSchemaOnly, "", false, "Only validate the spec schema and not the correctness of the spec.")
+	AddBoolFlag(validateCmd, doctl.ArgOnlineValidate, "", false, "Also verify that the container images, managed databases, and environment variable references used by the spec exist. Each missing reference is reported as a warning.")
+	validateCmd.Example = `The following example validates an app spec generated by another command, reading it from stdin: ./generate-spec.sh | doctl apps spec validate -`
 
 	return cmd
 }
@@ -1015,7 +1365,7 @@ func RunAppsSpecGet(c *CmdConfig) error {
 		e := json.NewEncoder(c.Out)
 		e.SetIndent("", "  ")
 		return e.Encode(spec)
-	case "yaml":
+	case "", "yaml":
 		yaml, err := yaml.Marshal(spec)
 		if err != nil {
 			return fmt.Errorf("marshaling the spec as yaml: %v", err)
@@ -1045,6 +1395,15 @@ func RunAppsSpecValidate(c *CmdConfig) error {
 		return err
 	}
 
+	onlineValidate, err := c.Doit.GetBool(c.NS, doctl.ArgOnlineValidate)
+	if err != nil {
+		return err
+	}
+
+	if schemaOnly && onlineValidate {
+		return fmt.Errorf("the --%s and --%s flags cannot be used together", doctl.ArgSchemaOnly, doctl.ArgOnlineValidate)
+	}
+
 	// validate schema only (offline)
 	if schemaOnly {
 		ymlSpec, err := yaml.Marshal(appSpec)
@@ -1067,6 +1426,16 @@ func RunAppsSpecValidate(c *CmdConfig) error {
 		return err
 	}
 
+	if onlineValidate {
+		missing, err := appSpecMissingReferences(c, appSpec)
+		if err != nil {
+			return err
+		}
+		for _, m := range missing {
+			warn("%s", m)
+		}
+	}
+
 	ymlSpec, err := yaml.Marshal(res.Spec)
 	if err != nil {
 		return fmt.Errorf("marshaling the spec as yaml: %v", err)
@@ -1075,6 +1444,104 @@ func RunAppsSpecValidate(c *CmdConfig) error {
 	return err
 }
 
+var appSpecEnvReferenceRegexp = regexp.MustCompile(`\$\{([A-Za-z0-9_-]+)\.[A-Za-z0-9_]+\}`)
+
+// appSpecMissingReferences returns a message for each resource referenced by
+// the spec that could not be found: DOCR images, production databases, and
+// components referenced by app-level environment variables.
+func appSpecMissingReferences(c *CmdConfig, spec *godo.AppSpec) ([]string, error) {
+	var missing []string
+
+	var registryName string
+	err := godo.ForEachAppSpecComponent(spec, func(component godo.AppContainerComponentSpec) error {
+		image := component.GetImage()
+		if image == nil || image.RegistryType != godo.ImageSourceSpecRegistryType_DOCR {
+			return nil
+		}
+
+		if registryName == "" {
+			registry, err := c.Registry().Get()
+			if err != nil {
+				missing = append(missing, fmt.Sprintf("component %s: image %s cannot be verified: %v", component.GetName(), image.Repository, err))
+				return nil
+			}
+			registryName = registry.Name
+		}
+
+		if image.Digest != "" {
+			manifests, err := c.Registry().ListRepositoryManifests(registryName, image.Repository)
+			if err != nil {
+				missing = append(missing, fmt.Sprintf("component %s: repository %s not found in registry %s", component.GetName(), image.Repository, registryName))
+				return nil
+			}
+			for _, m := range manifests {
+				if m.Digest == image.Digest {
+					return nil
+				}
+			}
+			missing = append(missing, fmt.Sprintf("component %s: image %s@%s not found in registry %s", component.GetName(), image.Repository, image.Digest, registryName))
+			return nil
+		}
+
+		tag := image.Tag
+		if tag == "" {
+			tag = "latest"
+		}
+		tags, err := c.Registry().ListRepositoryTags(registryName, image.Repository)
+		if err != nil {
+			missing = append(missing, fmt.Sprintf("component %s: repository %s not found in registry %s", component.GetName(), image.Repository, registryName))
+			return nil
+		}
+		for _, t := range tags {
+			if t.Tag == tag {
+				return nil
+			}
+		}
+		missing = append(missing, fmt.Sprintf("component %s: image %s:%s not found in registry %s", component.GetName(), image.Repository, tag, registryName))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var clusters map[string]bool
+	for _, db := range spec.Databases {
+		if !db.Production {
+			continue
+		}
+
+		if clusters == nil {
+			dbs, err := c.Databases().List()
+			if err != nil {
+				return nil, err
+			}
+			clusters = make(map[string]bool, len(dbs))
+			for _, d := range dbs {
+				clusters[d.Name] = true
+			}
+		}
+
+		if !clusters[db.ClusterName] {
+			missing = append(missing, fmt.Sprintf("database %s: database cluster %s not found", db.Name, db.ClusterName))
+		}
+	}
+
+	components := make(map[string]bool)
+	_ = spec.ForEachAppComponentSpec(func(component godo.AppComponentSpec) error {
+		components[component.GetName()] = true
+		return nil
+	})
+	for _, env := range spec.Envs {
+		for _, match := range appSpecEnvReferenceRegexp.FindAllStringSubmatch(env.Value, -1) {
+			if !components[match[1]] {
+				missing = append(missing, fmt.Sprintf("environment variable %s: referenced component %s not found", env.Key, match[1]))
+			}
+		}
+	}
+
+	return missing, nil
+}
+
 // RunAppsListRegions lists all app platform regions.
 func RunAppsListRegions(c *CmdConfig) error {
 	regions, err := c.Apps().ListRegions()
@@ -1082,6 +1549,14 @@ func RunAppsListRegions(c *CmdConfig) error {
 		return err
 	}
 
+	// List the default region first, then the rest by slug.
+	sort.SliceStable(regions, func(i, j int) bool {
+		if regions[i].Default != regions[j].Default {
+			return regions[i].Default
+		}
+		return regions[i].Slug < regions[j].Slug
+	})
+
 	return c.Display(displayers.AppRegions(regions))
 }
 
@@ -1138,7 +1613,10 @@ func appsTierInstanceSize() *Command {
 		},
 	}
 
-	CmdBuilder(cmd, RunAppsTierInstanceSizeList, "list", "List all app instance sizes", `Use this command to list all the available app instance sizes.`, Writer, aliasOpt("ls"))
+	cmdInstanceSizeList := CmdBuilder(cmd, RunAppsTierInstanceSizeList, "list", "List all app instance sizes", `Use this command to list all the available app instance sizes, including their monthly price in USD.`, Writer, aliasOpt("ls"))
+	AddStringFlag(cmdInstanceSizeList, doctl.ArgSortBy, "", "", "Sort the instance sizes by the given field. Possible values: `price`")
+	AddStringFlag(cmdInstanceSizeList, doctl.ArgAppTier, "", "", "Lists only the instance sizes in the given tier, such as `basic` or `professional`")
+	cmdInstanceSizeList.Example = `The following example lists all app instance sizes from the cheapest to the most expensive: doctl apps tier instance-size list --sort-by price`
 	CmdBuilder(cmd, RunAppsTierInstanceSizeGet, "get <instance size slug>", "Retrieve an app instance size", `Use this command to retrieve information about a specific app instance size.`, Writer)
 
 	return cmd
@@ -1146,11 +1624,42 @@ func appsTierInstanceSize() *Command {
 
 // RunAppsTierInstanceSizeList lists all app tiers.
 func RunAppsTierInstanceSizeList(c *CmdConfig) error {
+	sortBy, err := c.Doit.GetString(c.NS, doctl.ArgSortBy)
+	if err != nil {
+		return err
+	}
+	if sortBy != "" && sortBy != "price" {
+		return fmt.Errorf("invalid value %q for --%s; possible values: price", sortBy, doctl.ArgSortBy)
+	}
+
+	tier, err := c.Doit.GetString(c.NS, doctl.ArgAppTier)
+	if err != nil {
+		return err
+	}
+
 	instanceSizes, err := c.Apps().ListInstanceSizes()
 	if err != nil {
 		return err
 	}
 
+	if tier != "" {
+		filtered := make([]*godo.AppInstanceSize, 0, len(instanceSizes))
+		for _, size := range instanceSizes {
+			if strings.EqualFold(size.TierSlug, tier) {
+				filtered = append(filtered, size)
+			}
+		}
+		instanceSizes = filtered
+	}
+
+	if sortBy == "price" {
+		sort.SliceStable(instanceSizes, func(i, j int) bool {
+			pi, _ := strconv.ParseFloat(instanceSizes[i].USDPerMonth, 64)
+			pj, _ := strconv.ParseFloat(instanceSizes[j].USDPerMonth, 64)
+			return pi < pj
+		})
+	}
+
 	return c.Display(displayers.AppInstanceSizes(instanceSizes))
 }
 
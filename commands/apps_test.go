@@ -2,17 +2,24 @@ package commands
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"io"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/digitalocean/doctl"
+	"github.com/digitalocean/doctl/do"
 	"github.com/digitalocean/doctl/pkg/listen"
 	"github.com/digitalocean/doctl/pkg/terminal"
 	"github.com/digitalocean/godo"
+	"github.com/digitalocean/godo/metrics"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -36,6 +43,7 @@ func TestAppsCommand(t *testing.T) {
 		"list-deployments",
 		"list-regions",
 		"logs",
+		"metrics",
 		"propose",
 		"restart",
 		"spec",
@@ -151,6 +159,7 @@ func TestRunAppsCreate(t *testing.T) {
 			Spec: &testAppSpec,
 		}
 
+		tm.apps.EXPECT().Propose(&godo.AppProposeRequest{Spec: &testAppSpec}).Times(1).Return(&godo.AppProposeResponse{Spec: &testAppSpec}, nil)
 		tm.apps.EXPECT().Create(createReq).Times(1).Return(app, nil)
 
 		config.Doit.Set(config.NS, doctl.ArgAppSpec, specFile.Name())
@@ -160,6 +169,93 @@ func TestRunAppsCreate(t *testing.T) {
 	})
 }
 
+func TestRunAppsCreateCopyEnvFrom(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		spec := &godo.AppSpec{
+			Name: "staging",
+			Envs: []*godo.AppVariableDefinition{{Key: "ENV", Value: "staging"}},
+			Services: []*godo.AppServiceSpec{{
+				Name: "web",
+				Envs: []*godo.AppVariableDefinition{{Key: "PORT", Value: "8080"}},
+			}},
+		}
+		specFile, err := os.CreateTemp(t.TempDir(), "spec")
+		require.NoError(t, err)
+		defer specFile.Close()
+		err = json.NewEncoder(specFile).Encode(spec)
+		require.NoError(t, err)
+
+		sourceID := uuid.New().String()
+		source := &godo.App{
+			ID: sourceID,
+			Spec: &godo.AppSpec{
+				Name: "production",
+				Envs: []*godo.AppVariableDefinition{
+					{Key: "ENV", Value: "production"},
+					{Key: "LOG_LEVEL", Value: "info"},
+				},
+				Services: []*godo.AppServiceSpec{{
+					Name: "web",
+					Envs: []*godo.AppVariableDefinition{
+						{Key: "PORT", Value: "80"},
+						{Key: "API_KEY", Value: "EV[1:abc]", Type: godo.AppVariableType_Secret},
+					},
+				}},
+				Workers: []*godo.AppWorkerSpec{{
+					Name: "queue",
+					Envs: []*godo.AppVariableDefinition{{Key: "CONCURRENCY", Value: "4"}},
+				}},
+			},
+		}
+
+		expected := &godo.AppSpec{
+			Name: "staging",
+			Envs: []*godo.AppVariableDefinition{
+				{Key: "ENV", Value: "staging"},
+				{Key: "LOG_LEVEL", Value: "info"},
+			},
+			Services: []*godo.AppServiceSpec{{
+				Name: "web",
+				Envs: []*godo.AppVariableDefinition{
+					{Key: "PORT", Value: "8080"},
+					{Key: "API_KEY", Type: godo.AppVariableType_Secret},
+				},
+			}},
+		}
+		app := &godo.App{ID: uuid.New().String(), Spec: expected}
+
+		tm.apps.EXPECT().Get(sourceID).Times(1).Return(source, nil)
+		tm.apps.EXPECT().Propose(&godo.AppProposeRequest{Spec: expected}).Times(1).Return(&godo.AppProposeResponse{Spec: expected}, nil)
+		tm.apps.EXPECT().Create(&godo.AppCreateRequest{Spec: expected}).Times(1).Return(app, nil)
+
+		config.Doit.Set(config.NS, doctl.ArgAppSpec, specFile.Name())
+		config.Doit.Set(config.NS, doctl.ArgAppCopyEnvFrom, sourceID)
+
+		warnings := captureStderr(t, func() { err = RunAppsCreate(config) })
+		require.NoError(t, err)
+		assert.Contains(t, warnings, "must be re-entered: web/API_KEY")
+	})
+}
+
+func TestRunAppsCreateInvalidSource(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		specFile, err := os.CreateTemp(t.TempDir(), "spec")
+		require.NoError(t, err)
+		defer specFile.Close()
+
+		err = json.NewEncoder(specFile).Encode(&testAppSpec)
+		require.NoError(t, err)
+
+		proposeErr := errors.New("error validating app spec: component_missing_source")
+		tm.apps.EXPECT().Propose(&godo.AppProposeRequest{Spec: &testAppSpec}).Times(1).Return(nil, proposeErr)
+
+		config.Doit.Set(config.NS, doctl.ArgAppSpec, specFile.Name())
+
+		err = RunAppsCreate(config)
+		require.Equal(t, proposeErr, err)
+	})
+}
+
 func TestRunAppsGet(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		app := &godo.App{
@@ -223,6 +319,26 @@ func TestRunAppsUpdate(t *testing.T) {
 	})
 }
 
+func TestAppSpecDiff(t *testing.T) {
+	submitted := &godo.AppSpec{Name: "test", Region: "nyc"}
+
+	diff, err := appSpecDiff(submitted, &godo.AppSpec{Name: "test", Region: "nyc"})
+	require.NoError(t, err)
+	assert.Empty(t, diff)
+
+	diff, err = appSpecDiff(submitted, &godo.AppSpec{Name: "test", Region: "nyc", Features: []string{"buildpack-stack=ubuntu-22"}})
+	require.NoError(t, err)
+	assert.Equal(t, `--- submitted
++++ applied
+@@ -1,3 +1,5 @@
++features:
++- buildpack-stack=ubuntu-22
+ name: test
+ region: nyc
+ 
+`, diff)
+}
+
 func TestRunAppsDelete(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		app := &godo.App{
@@ -526,6 +642,31 @@ func TestRunAppsListDeployments(t *testing.T) {
 	})
 }
 
+func TestRunAppsListDeploymentsWithLimit(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		appID := uuid.New().String()
+		now := time.Now()
+		deployments := []*godo.Deployment{
+			{ID: "oldest", CreatedAt: now.Add(-2 * time.Hour)},
+			{ID: "newest", CreatedAt: now},
+			{ID: "middle", CreatedAt: now.Add(-time.Hour)},
+		}
+
+		tm.apps.EXPECT().ListDeployments(appID).Times(1).Return(deployments, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Args = append(config.Args, appID)
+		config.Doit.Set(config.NS, doctl.ArgLimit, 2)
+		config.Doit.Set(config.NS, doctl.ArgFormat, "ID")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := RunAppsListDeployments(config)
+		require.NoError(t, err)
+		assert.Equal(t, "newest\nmiddle\n", buf.String())
+	})
+}
+
 func TestRunAppsGetLogs(t *testing.T) {
 	appID := uuid.New().String()
 	deploymentID := uuid.New().String()
@@ -561,6 +702,126 @@ func TestRunAppsGetLogs(t *testing.T) {
 	}
 }
 
+func TestRunAppsGetLogsReconnect(t *testing.T) {
+	appID := uuid.New().String()
+	deploymentID := uuid.New().String()
+	component := "service"
+	liveURL := "https://proxy-apps-prod-ams3-001.ondigitalocean.app/?token=aa-bb-11-cc-33"
+
+	backoff := appLogsReconnectBackoff
+	appLogsReconnectBackoff = 0
+	defer func() { appLogsReconnectBackoff = backoff }()
+
+	dropped := errors.New("error reading from websocket: unexpected EOF")
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		var (
+			buf        bytes.Buffer
+			schemaFunc listen.SchemaFunc
+		)
+		// deliver simulates the live log stream sending a line.
+		deliver := func(context.Context) error {
+			r, err := schemaFunc([]byte(`{"data":"service 2026-01-01T00:00:00Z hello\n"}`))
+			if err != nil {
+				return err
+			}
+			_, err = io.Copy(&buf, r)
+			return err
+		}
+
+		gomock.InOrder(
+			tm.apps.EXPECT().GetLogs(appID, deploymentID, component, godo.AppLogTypeRun, true, 5).Return(&godo.AppLogs{LiveURL: liveURL}, nil),
+			tm.listen.EXPECT().Listen(gomock.Any()).Return(dropped),
+			tm.apps.EXPECT().GetLogs(appID, deploymentID, component, godo.AppLogTypeRun, true, 0).Return(&godo.AppLogs{LiveURL: liveURL}, nil),
+			tm.listen.EXPECT().Listen(gomock.Any()).DoAndReturn(deliver),
+		)
+
+		tc := config.Doit.(*doctl.TestConfig)
+		tc.ListenFn = func(url *url.URL, token string, sf listen.SchemaFunc, out io.Writer, in <-chan []byte) listen.ListenerService {
+			schemaFunc = sf
+			return tm.listen
+		}
+
+		config.Out = &buf
+		config.Args = append(config.Args, appID, component)
+		config.Doit.Set(config.NS, doctl.ArgAppDeployment, deploymentID)
+		config.Doit.Set(config.NS, doctl.ArgAppLogType, "run")
+		config.Doit.Set(config.NS, doctl.ArgAppLogFollow, true)
+		config.Doit.Set(config.NS, doctl.ArgAppLogTail, 5)
+
+		var err error
+		notices := captureStderr(t, func() { err = RunAppsGetLogs(config) })
+		require.NoError(t, err)
+		assert.Equal(t, "service 2026-01-01T00:00:00Z hello\n", buf.String())
+		assert.Contains(t, notices, "Reconnected to the log stream")
+	})
+
+	// The attempt limit only applies to consecutive failures.
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		var schemaFunc listen.SchemaFunc
+		deliverThenDrop := func(context.Context) error {
+			if _, err := schemaFunc([]byte(`{"data":"line"}`)); err != nil {
+				return err
+			}
+			return dropped
+		}
+
+		calls := []*gomock.Call{
+			tm.apps.EXPECT().GetLogs(appID, deploymentID, component, godo.AppLogTypeRun, true, 5).Return(&godo.AppLogs{LiveURL: liveURL}, nil),
+			tm.listen.EXPECT().Listen(gomock.Any()).Return(dropped),
+			tm.apps.EXPECT().GetLogs(appID, deploymentID, component, godo.AppLogTypeRun, true, 0).Return(&godo.AppLogs{LiveURL: liveURL}, nil),
+			tm.listen.EXPECT().Listen(gomock.Any()).DoAndReturn(deliverThenDrop),
+		}
+		for i := 1; i < appLogsMaxReconnects; i++ {
+			calls = append(calls,
+				tm.apps.EXPECT().GetLogs(appID, deploymentID, component, godo.AppLogTypeRun, true, 0).Return(&godo.AppLogs{LiveURL: liveURL}, nil),
+				tm.listen.EXPECT().Listen(gomock.Any()).Return(dropped),
+			)
+		}
+		calls = append(calls,
+			tm.apps.EXPECT().GetLogs(appID, deploymentID, component, godo.AppLogTypeRun, true, 0).Return(&godo.AppLogs{LiveURL: liveURL}, nil),
+			tm.listen.EXPECT().Listen(gomock.Any()).Return(nil),
+		)
+		gomock.InOrder(calls...)
+
+		tc := config.Doit.(*doctl.TestConfig)
+		tc.ListenFn = func(url *url.URL, token string, sf listen.SchemaFunc, out io.Writer, in <-chan []byte) listen.ListenerService {
+			schemaFunc = sf
+			return tm.listen
+		}
+
+		config.Args = append(config.Args, appID, component)
+		config.Doit.Set(config.NS, doctl.ArgAppDeployment, deploymentID)
+		config.Doit.Set(config.NS, doctl.ArgAppLogType, "run")
+		config.Doit.Set(config.NS, doctl.ArgAppLogFollow, true)
+		config.Doit.Set(config.NS, doctl.ArgAppLogTail, 5)
+
+		var err error
+		captureStderr(t, func() { err = RunAppsGetLogs(config) })
+		require.NoError(t, err)
+	})
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.apps.EXPECT().GetLogs(appID, deploymentID, component, godo.AppLogTypeRun, true, 5).Return(&godo.AppLogs{LiveURL: liveURL}, nil)
+		tm.listen.EXPECT().Listen(gomock.Any()).Return(dropped)
+
+		tc := config.Doit.(*doctl.TestConfig)
+		tc.ListenFn = func(url *url.URL, token string, schemaFunc listen.SchemaFunc, out io.Writer, in <-chan []byte) listen.ListenerService {
+			return tm.listen
+		}
+
+		config.Args = append(config.Args, appID, component)
+		config.Doit.Set(config.NS, doctl.ArgAppDeployment, deploymentID)
+		config.Doit.Set(config.NS, doctl.ArgAppLogType, "run")
+		config.Doit.Set(config.NS, doctl.ArgAppLogFollow, true)
+		config.Doit.Set(config.NS, doctl.ArgAppLogTail, 5)
+		config.Doit.Set(config.NS, doctl.ArgAppLogNoReconnect, true)
+
+		err := RunAppsGetLogs(config)
+		assert.EqualError(t, err, "error reading from websocket: unexpected EOF")
+	})
+}
+
 func TestRunAppsGetLogsWithAppName(t *testing.T) {
 	appName := "test-app"
 	component := "service"
@@ -605,6 +866,94 @@ func TestRunAppsGetLogsWithAppName(t *testing.T) {
 	}
 }
 
+func TestRunAppsMetrics(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		appID := uuid.New().String()
+		start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		end := start.Add(time.Hour)
+		ts := metrics.TimeFromUnix(start.Unix())
+
+		tm.monitoring.EXPECT().GetAppMetrics(appID, "web", "memory_percentage", start, end).Return(&godo.MetricsResponse{
+			Data: godo.MetricsData{Result: []metrics.SampleStream{
+				{Metric: metrics.Metric{"pod": "web-1"}, Values: []metrics.SamplePair{{Timestamp: ts, Value: 40}}},
+				{Metric: metrics.Metric{"pod": "web-2"}, Values: []metrics.SamplePair{{Timestamp: ts, Value: 60}}},
+			}},
+		}, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Args = append(config.Args, appID, "web")
+		config.Doit.Set(config.NS, doctl.ArgAppMetric, "memory")
+		config.Doit.Set(config.NS, doctl.ArgMetricsStart, start.Format(time.RFC3339))
+		config.Doit.Set(config.NS, doctl.ArgMetricsEnd, end.Format(time.RFC3339))
+
+		err := RunAppsMetrics(config)
+		require.NoError(t, err)
+		assert.Contains(t, buf.String(), "Memory")
+		assert.Contains(t, buf.String(), "2026-01-01T00:00:00Z    50.00")
+	})
+}
+
+func TestRunAppsMetricsInvalidMetric(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Args = append(config.Args, uuid.New().String(), "web")
+		config.Doit.Set(config.NS, doctl.ArgAppMetric, "disk")
+
+		err := RunAppsMetrics(config)
+		assert.EqualError(t, err, `invalid value "disk" for --metric; possible values: cpu, memory, restarts`)
+	})
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Args = append(config.Args, uuid.New().String(), "web")
+		config.Doit.Set(config.NS, doctl.ArgAppMetric, "http_requests")
+
+		err := RunAppsMetrics(config)
+		assert.EqualError(t, err, "the monitoring API does not expose HTTP request metrics for app components; possible values for --metric: cpu, memory, restarts")
+	})
+}
+
+func TestRunAppsGetLogsPicksComponent(t *testing.T) {
+	deploymentID := uuid.New().String()
+	testApp := &godo.App{
+		ID:   uuid.New().String(),
+		Spec: &testAppSpec,
+	}
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.apps.EXPECT().Get(testApp.ID).Times(1).Return(testApp, nil)
+		tm.apps.EXPECT().GetLogs(testApp.ID, deploymentID, "service", godo.AppLogTypeRun, false, -1).Times(1).Return(&godo.AppLogs{}, nil)
+
+		config.Args = append(config.Args, testApp.ID)
+		config.Doit.Set(config.NS, doctl.ArgAppDeployment, deploymentID)
+		config.Doit.Set(config.NS, doctl.ArgAppLogType, "run")
+		config.Doit.Set(config.NS, doctl.ArgAppLogTail, -1)
+
+		err := RunAppsGetLogs(config)
+		require.NoError(t, err)
+	})
+}
+
+func TestRunAppsGetLogsNoRunComponents(t *testing.T) {
+	deploymentID := uuid.New().String()
+	testApp := &godo.App{
+		ID: uuid.New().String(),
+		Spec: &godo.AppSpec{
+			Name:        "static",
+			StaticSites: []*godo.AppStaticSiteSpec{{Name: "site"}},
+		},
+	}
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.apps.EXPECT().Get(testApp.ID).Times(1).Return(testApp, nil)
+
+		config.Args = append(config.Args, testApp.ID)
+		config.Doit.Set(config.NS, doctl.ArgAppDeployment, deploymentID)
+		config.Doit.Set(config.NS, doctl.ArgAppLogType, "run")
+
+		err := RunAppsGetLogs(config)
+		require.EqualError(t, err, "app static has no components that produce run logs")
+	})
+}
+
 func TestRunAppsGetLogsWithAppNameAndDeploymentID(t *testing.T) {
 	appName := "test-app"
 	component := "service"
@@ -649,6 +998,57 @@ func TestRunAppsGetLogsWithAppNameAndDeploymentID(t *testing.T) {
 	}
 }
 
+func TestRunAppsGetLogsBuildForHistoricalDeployment(t *testing.T) {
+	component := "service"
+	historicalDeploymentID := uuid.New().String()
+
+	testApp := &godo.App{
+		ID:   uuid.New().String(),
+		Spec: &testAppSpec,
+		ActiveDeployment: &godo.Deployment{
+			ID:   uuid.New().String(),
+			Spec: &testAppSpec,
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("service 2024-01-01T00:00:00Z building\nservice 2024-01-01T00:00:01Z build complete\n"))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name string
+		app  string
+		find bool
+	}{
+		{name: "by app id", app: testApp.ID},
+		{name: "by app name", app: "test-app", find: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+				if tt.find {
+					tm.apps.EXPECT().Find(tt.app).Times(1).Return(testApp, nil)
+				}
+				tm.apps.EXPECT().GetLogs(testApp.ID, historicalDeploymentID, component, godo.AppLogTypeBuild, false, -1).Times(1).Return(&godo.AppLogs{HistoricURLs: []string{server.URL}}, nil)
+
+				var buf bytes.Buffer
+				config.Out = &buf
+				config.Args = append(config.Args, tt.app, component)
+				config.Doit.Set(config.NS, doctl.ArgAppDeployment, historicalDeploymentID)
+				config.Doit.Set(config.NS, doctl.ArgAppLogType, "build")
+				config.Doit.Set(config.NS, doctl.ArgAppLogFollow, false)
+				config.Doit.Set(config.NS, doctl.ArgAppLogTail, -1)
+
+				err := RunAppsGetLogs(config)
+				require.NoError(t, err)
+				assert.Equal(t, "service 2024-01-01T00:00:00Z building\nservice 2024-01-01T00:00:01Z build complete\n", buf.String())
+			})
+		})
+	}
+}
+
 func TestRunAppsConsole(t *testing.T) {
 	appID := uuid.New().String()
 	deploymentID := uuid.New().String()
@@ -707,6 +1107,32 @@ func TestRunAppsConsole(t *testing.T) {
 		err := RunAppsConsole(config)
 		require.NoError(t, err)
 	})
+
+	// read-only session
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		opts := &godo.AppGetExecOptions{
+			DeploymentID: deploymentID,
+		}
+		tm.apps.EXPECT().GetExecWithOpts(appID, componentName, opts).Times(1).Return(&godo.AppExec{URL: "wss://proxy-apps-prod-ams3-001.ondigitalocean.app/?token=aa-bb-11-cc-33"}, nil)
+		tm.listen.EXPECT().Listen(gomock.Any()).Times(1).Return(nil)
+		// ReadRawStdin must not be called
+		tm.terminal.EXPECT().MonitorResizeEvents(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+
+		tc := config.Doit.(*doctl.TestConfig)
+		tc.ListenFn = func(url *url.URL, token string, schemaFunc listen.SchemaFunc, out io.Writer, in <-chan []byte) listen.ListenerService {
+			return tm.listen
+		}
+		tc.TerminalFn = func() terminal.Terminal {
+			return tm.terminal
+		}
+
+		config.Args = append(config.Args, appID, componentName)
+		config.Doit.Set(config.NS, doctl.ArgAppDeployment, deploymentID)
+		config.Doit.Set(config.NS, doctl.ArgAppConsoleNoStdin, true)
+
+		err := RunAppsConsole(config)
+		require.NoError(t, err)
+	})
 }
 
 const (
@@ -801,6 +1227,68 @@ func testTempFile(t *testing.T, data []byte) string {
 	return file
 }
 
+func TestAppSpecMissingReferences(t *testing.T) {
+	spec := &godo.AppSpec{
+		Name: "test",
+		Services: []*godo.AppServiceSpec{
+			{
+				Name: "web",
+				Image: &godo.ImageSourceSpec{
+					RegistryType: godo.ImageSourceSpecRegistryType_DOCR,
+					Repository:   "web",
+					Tag:          "v1",
+				},
+			},
+			{
+				Name: "api",
+				Image: &godo.ImageSourceSpec{
+					RegistryType: godo.ImageSourceSpecRegistryType_DOCR,
+					Repository:   "api",
+				},
+			},
+			{
+				Name: "hub",
+				Image: &godo.ImageSourceSpec{
+					RegistryType: godo.ImageSourceSpecRegistryType_DockerHub,
+					Registry:     "library",
+					Repository:   "nginx",
+				},
+			},
+		},
+		Databases: []*godo.AppDatabaseSpec{
+			{Name: "db", Production: true, ClusterName: "prod-cluster"},
+			{Name: "missing-db", Production: true, ClusterName: "nonexistent"},
+			{Name: "dev-db"},
+		},
+		Envs: []*godo.AppVariableDefinition{
+			{Key: "DATABASE_URL", Value: "${db.DATABASE_URL}"},
+			{Key: "CACHE_URL", Value: "${cache.REDIS_URL}"},
+			{Key: "APP_URL", Value: "${APP_URL}"},
+		},
+	}
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.registry.EXPECT().Get().Return(&do.Registry{Registry: &godo.Registry{Name: "my-registry"}}, nil)
+		tm.registry.EXPECT().ListRepositoryTags("my-registry", "web").Return([]do.RepositoryTag{
+			{RepositoryTag: &godo.RepositoryTag{Tag: "v1"}},
+		}, nil)
+		tm.registry.EXPECT().ListRepositoryTags("my-registry", "api").Return([]do.RepositoryTag{
+			{RepositoryTag: &godo.RepositoryTag{Tag: "v2"}},
+		}, nil)
+		tm.databases.EXPECT().List().Return(do.Databases{
+			{Database: &godo.Database{Name: "prod-cluster"}},
+		}, nil)
+
+		missing, err := appSpecMissingReferences(config, spec)
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"component api: image api:latest not found in registry my-registry",
+			"database missing-db: database cluster nonexistent not found",
+			"environment variable CACHE_URL: referenced component cache not found",
+		}, missing)
+	})
+}
+
 func TestRunAppSpecValidate(t *testing.T) {
 	tcs := []struct {
 		name       string
@@ -870,6 +1358,29 @@ func TestRunAppSpecValidate(t *testing.T) {
 	}
 }
 
+func TestRunAppSpecValidateStdin(t *testing.T) {
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+	defer func(stdin *os.File) { os.Stdin = stdin }(os.Stdin)
+	os.Stdin = r
+
+	go func() {
+		_, _ = io.Copy(w, strings.NewReader(validJSONSpec))
+		w.Close()
+	}()
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Args = append(config.Args, "-")
+		config.Doit.Set(config.NS, doctl.ArgSchemaOnly, true)
+		var buf bytes.Buffer
+		config.Out = &buf
+
+		err := RunAppsSpecValidate(config)
+		require.NoError(t, err)
+		assert.Equal(t, validYAMLSpec, buf.String())
+	})
+}
+
 func TestRunAppSpecGet(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		app := &godo.App{
@@ -877,7 +1388,24 @@ func TestRunAppSpecGet(t *testing.T) {
 			Spec: &testAppSpec,
 		}
 
-		tm.apps.EXPECT().Get(app.ID).Times(2).Return(app, nil)
+		tm.apps.EXPECT().Get(app.ID).Times(3).Return(app, nil)
+
+		t.Run("default", func(t *testing.T) {
+			var buf bytes.Buffer
+			config.Doit.Set(config.NS, doctl.ArgFormat, "")
+			config.Args = append(config.Args, app.ID)
+			config.Out = &buf
+
+			err := RunAppsSpecGet(config)
+			require.NoError(t, err)
+			require.Equal(t, `name: test
+services:
+- github:
+    branch: main
+    repo: digitalocean/doctl
+  name: service
+`, buf.String())
+		})
 
 		t.Run("yaml", func(t *testing.T) {
 			var buf bytes.Buffer
@@ -967,6 +1495,27 @@ func TestRunAppsListRegions(t *testing.T) {
 	})
 }
 
+func TestRunAppsListRegionsSorted(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		regions := []*godo.AppRegion{
+			{Slug: "sfo"},
+			{Slug: "nyc", Default: true},
+			{Slug: "ams"},
+		}
+
+		tm.apps.EXPECT().ListRegions().Times(1).Return(regions, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Doit.Set(config.NS, doctl.ArgFormat, "Slug")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := RunAppsListRegions(config)
+		require.NoError(t, err)
+		assert.Equal(t, "nyc\nams\nsfo\n", buf.String())
+	})
+}
+
 func TestRunAppsTierList(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		tiers := []*godo.AppTier{testAppTier}
@@ -999,6 +1548,57 @@ func TestRunAppsTierInstanceSizeList(t *testing.T) {
 	})
 }
 
+func TestRunAppsTierInstanceSizeListSortByPrice(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		instanceSizes := []*godo.AppInstanceSize{
+			{Slug: "professional-xs", USDPerMonth: "12"},
+			{Slug: "basic-xxs", USDPerMonth: "5"},
+			{Slug: "professional-1l", USDPerMonth: "150"},
+		}
+		tm.apps.EXPECT().ListInstanceSizes().Times(1).Return(instanceSizes, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Doit.Set(config.NS, doctl.ArgSortBy, "price")
+		config.Doit.Set(config.NS, doctl.ArgFormat, "Slug,USDPerMonth")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := RunAppsTierInstanceSizeList(config)
+		require.NoError(t, err)
+		assert.Equal(t, "basic-xxs          5\nprofessional-xs    12\nprofessional-1l    150\n", buf.String())
+	})
+}
+
+func TestRunAppsTierInstanceSizeListTier(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		instanceSizes := []*godo.AppInstanceSize{
+			{Slug: "professional-xs", TierSlug: "professional"},
+			{Slug: "basic-xxs", TierSlug: "basic"},
+			{Slug: "basic-xs", TierSlug: "basic"},
+		}
+		tm.apps.EXPECT().ListInstanceSizes().Times(1).Return(instanceSizes, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Doit.Set(config.NS, doctl.ArgAppTier, "basic")
+		config.Doit.Set(config.NS, doctl.ArgFormat, "Slug")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := RunAppsTierInstanceSizeList(config)
+		require.NoError(t, err)
+		assert.Equal(t, "basic-xxs\nbasic-xs\n", buf.String())
+	})
+}
+
+func TestRunAppsTierInstanceSizeListInvalidSort(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Doit.Set(config.NS, doctl.ArgSortBy, "memory")
+
+		err := RunAppsTierInstanceSizeList(config)
+		require.Error(t, err)
+	})
+}
+
 func TestRunAppsTierInstanceSizeGet(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		tm.apps.EXPECT().GetInstanceSize(testAppInstanceSize.Slug).Times(1).Return(testAppInstanceSize, nil)
@@ -15,6 +15,7 @@ package commands
 
 import (
 	"os"
+	"time"
 
 	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/commands/displayers"
@@ -61,7 +62,7 @@ To create a Let's Encrypt certificate, you need to add the domain(s) to your acc
 
 To upload a custom certificate, you need to provide a certificate name, the path to the certificate, the path to the certificate's private key, and the path to the certificate chain, all in PEM format:
 
-	doctl compute certificate create --type custom --name mycert --leaf-certificate-path cert.pem --certificate-chain-path fullchain.pem --private-key-path privkey.pem`, Writer, aliasOpt("c"))
+	doctl compute certificate create --type custom --name mycert --leaf-certificate-path cert.pem --certificate-chain-path fullchain.pem --private-key-path privkey.pem`, Writer, aliasOpt("c"), displayerType(&displayers.Certificate{}))
 	AddStringFlag(cmdCertificateCreate, doctl.ArgCertificateName, "", "",
 		"A user-specified name for the certificate.", requiredOpt())
 	AddStringSliceFlag(cmdCertificateCreate, doctl.ArgCertificateDNSNames, "",
@@ -75,11 +76,15 @@ To upload a custom certificate, you need to provide a certificate name, the path
 	AddStringFlag(cmdCertificateCreate, doctl.ArgCertificateType, "", "",
 		"The type of certificate, `custom` or `lets_encrypt`.")
 
-	cmdCertificateList := CmdBuilder(cmd, RunCertificateList, "list", "Retrieve list of the account's stored certificates", `This command retrieves a list of all certificates associated with the account. The following details are shown for each certificate:`+certDetails, Writer,
+	cmdCertificateList := CmdBuilder(cmd, RunCertificateList, "list", "Retrieve list of the account's stored certificates", `This command retrieves a list of all certificates associated with the account. The following details are shown for each certificate:`+certDetails+`
+
+When printing to a terminal, certificates that have expired are shown in red and certificates that expire within 30 days are shown in yellow.`, Writer,
 		aliasOpt("ls"), displayerType(&displayers.Certificate{}))
 	cmdCertificateList.Example = `The following example retrieves a list of all certificates associated with your account and uses the ` + "`" + `--format` + "`" + ` flag return only the IDs, names, and the domains associated with each ticket: doctl compute certificate list --format ID,Name,DNSNames`
 	AddStringFlag(cmdCertificateList, doctl.ArgCertificateName, "", "",
 		"Filter certificates by the specified name")
+	AddDurationFlag(cmdCertificateList, doctl.ArgExpiringWithin, "", 0,
+		"Lists only certificates that have expired or expire within the given duration, for example `720h`")
 
 	cmdCertificateDelete := CmdBuilder(cmd, RunCertificateDelete, "delete <id>",
 		"Delete the specified certificate", `Deletes the specified certificate.
@@ -204,6 +209,24 @@ func RunCertificateList(c *CmdConfig) error {
 		return err
 	}
 
+	expiringWithin, err := c.Doit.GetDuration(c.NS, doctl.ArgExpiringWithin)
+	if err != nil {
+		return err
+	}
+	if expiringWithin > 0 {
+		var expiring do.Certificates
+		for _, cert := range list {
+			notAfter, err := time.Parse(time.RFC3339, cert.NotAfter)
+			if err != nil {
+				continue
+			}
+			if time.Until(notAfter) <= expiringWithin {
+				expiring = append(expiring, cert)
+			}
+		}
+		list = expiring
+	}
+
 	item := &displayers.Certificate{Certificates: list}
 	return c.Display(item)
 }
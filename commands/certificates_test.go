@@ -1,9 +1,11 @@
 package commands
 
 import (
+	"bytes"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/do"
@@ -176,6 +178,26 @@ func TestCertificateListByName(t *testing.T) {
 	})
 }
 
+func TestCertificateListExpiringWithin(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		valid := do.Certificate{Certificate: &godo.Certificate{
+			ID:       "4de7ac8b-495b-4884-9a69-1050c6793cd6",
+			Name:     "web-cert-02",
+			NotAfter: time.Now().Add(90 * 24 * time.Hour).Format(time.RFC3339),
+		}}
+		tm.certificates.EXPECT().List().Return(do.Certificates{testCertificate, valid}, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Doit.Set(config.NS, doctl.ArgExpiringWithin, 30*24*time.Hour)
+
+		err := RunCertificateList(config)
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), "web-cert-01")
+		assert.NotContains(t, buf.String(), "web-cert-02")
+	})
+}
+
 func TestCertificateDelete(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		cID := "892071a0-bb95-49bc-8021-3afd67a210bf"
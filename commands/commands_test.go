@@ -14,11 +14,13 @@ limitations under the License.
 package commands
 
 import (
+	"bytes"
 	"io"
 	"testing"
 	"time"
 
 	"github.com/digitalocean/godo"
+	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
@@ -294,6 +296,20 @@ type tcMocks struct {
 	genAI                 *domocks.MockGenAIService
 }
 
+// captureStderr runs fn with warnings and notices redirected to a buffer and
+// returns what was written to it.
+func captureStderr(t *testing.T, fn func()) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+	orig := color.Output
+	color.Output = &buf
+	defer func() { color.Output = orig }()
+
+	fn()
+	return buf.String()
+}
+
 func withTestClient(t *testing.T, tFn testFn) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/digitalocean/doctl/commands/charm/confirm"
+	"github.com/digitalocean/doctl/commands/charm/input"
 	"github.com/digitalocean/doctl/commands/charm/template"
 )
 
@@ -45,3 +46,26 @@ func AskForConfirmDelete(resourceType string, count int) error {
 
 	return nil
 }
+
+// AskForConfirmTyped asks the user to confirm an action by typing the
+// expected value, such as a resource name. Unlike AskForConfirm, it cannot be
+// bypassed when doctl is not running interactively.
+func AskForConfirmTyped(message, expected string) error {
+	if !Interactive {
+		warn("Requires confirmation. Run this command interactively to continue.")
+		return ErrExitSilently
+	}
+	value, err := input.New(
+		fmt.Sprintf("To %s, type %q: ", message, expected),
+		input.WithRequired(),
+	).Prompt()
+	if err != nil {
+		return err
+	}
+
+	if value != expected {
+		return fmt.Errorf("Invalid user input")
+	}
+
+	return nil
+}
@@ -14,6 +14,8 @@ limitations under the License.
 package commands
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
@@ -61,10 +63,18 @@ func Databases() *Command {
 - The number of nodes in the database cluster
 - The region the database cluster resides in, such as ` + "`sfo2`, " + "`nyc1`" + `
 - The current status of the database cluster, such as ` + "`online`" + `
-- The size of the machine running the database instance, such as ` + "`db-s-1vcpu-1gb`" + `)`
+- The size of the machine running the database instance, such as ` + "`db-s-1vcpu-1gb`" + `)
+- The day and hour of the cluster's maintenance window`
 
-	cmdDatabaseList := CmdBuilder(cmd, RunDatabaseList, "list", "List your database clusters", `Retrieves a list of database clusters and their following details:`+clusterDetails, Writer, aliasOpt("ls"), displayerType(&displayers.Databases{}))
-	cmdDatabaseList.Example = `The following example lists all database associated with your account and uses the ` + "`" + `--format` + "`" + ` flag to return only the ID, engine, and engine version of each database: doctl databases list --format ID,Engine,Version`
+	cmdDatabaseList := CmdBuilder(cmd, RunDatabaseList, "list", "List your database clusters", `Retrieves a list of database clusters and their following details:`+clusterDetails+`
+
+The `+"`HighAvailability`"+` column is `+"`true`"+` for clusters with more than one node, as nodes beyond the primary are standby nodes. It shows `+"`N/A`"+` for Kafka and OpenSearch clusters, whose additional nodes are not standbys.
+
+Use `+"`--format MonitoringURL`"+` to get a link to each cluster's monitoring dashboard in the control panel.`, Writer, aliasOpt("ls"), displayerType(&displayers.Databases{}))
+	AddStringFlag(cmdDatabaseList, doctl.ArgDatabaseEngine, "", "", "Lists only database clusters with the given engine. Possible values are: `pg`, `mysql`, `redis`, `valkey`, `mongodb`, `kafka` and `opensearch`.")
+	cmdDatabaseList.Example = `The following example lists all database associated with your account and uses the ` + "`" + `--format` + "`" + ` flag to return only the ID, engine, and engine version of each database: doctl databases list --format ID,Engine,Version
+
+The following example lists only your PostgreSQL database clusters: doctl databases list --engine pg`
 	cmdDatabaseGet := CmdBuilder(cmd, RunDatabaseGet, "get <database-cluster-id>", "Get details for a database cluster", `Retrieves the following details about the specified database cluster: `+clusterDetails+`
 - A connection string for the database cluster
 - The date and time when the database cluster was created`+databaseListDetails, Writer, aliasOpt("g"), displayerType(&displayers.Databases{}))
@@ -80,7 +90,7 @@ With the ` + "`" + `-o json flag` + "`" + `, the certificate to connect to the d
 	cmdDatabaseCreate := CmdBuilder(cmd, RunDatabaseCreate, "create <name>", "Create a database cluster", `Creates a database cluster with the specified name.
 
 You can customize the configuration using the listed flags, all of which are optional. Without any flags set, the command creates a single-node, single-CPU PostgreSQL database cluster.`, Writer,
-		aliasOpt("c"))
+		aliasOpt("c"), displayerType(&displayers.Databases{}))
 	AddIntFlag(cmdDatabaseCreate, doctl.ArgDatabaseNumNodes, "", defaultDatabaseNodeCount, nodeNumberDetails)
 	AddStringFlag(cmdDatabaseCreate, doctl.ArgRegionSlug, "", defaultDatabaseRegion, "The data center region where the database cluster resides, such as `nyc1` or `sfo2`.")
 	AddStringFlag(cmdDatabaseCreate, doctl.ArgSizeSlug, "", defaultDatabaseNodeSize, nodeSizeDetails)
@@ -145,7 +155,7 @@ For PostgreSQL and MySQL clusters, you can also provide a disk size in MiB to sc
 	AddStringFlag(cmdDatabaseMigrate, doctl.ArgPrivateNetworkUUID, "", "", "The UUID of a VPC network to create the database cluster in. The command uses the region's default VPC network if not specified.")
 	AddBoolFlag(cmdDatabaseMigrate, doctl.ArgCommandWait, "", false, "A boolean value that specifies whether to wait for the database migration to complete before returning control to the terminal.")
 
-	cmdDatabaseFork := CmdBuilder(cmd, RunDatabaseFork, "fork <name>", "Create a new database cluster by forking an existing database cluster.", `Creates a new database cluster from an existing cluster. The forked database contains all of the data from the original database at the time the fork is created.`, Writer, aliasOpt("f"))
+	cmdDatabaseFork := CmdBuilder(cmd, RunDatabaseFork, "fork <name>", "Create a new database cluster by forking an existing database cluster.", `Creates a new database cluster from an existing cluster. The forked database contains all of the data from the original database at the time the fork is created.`, Writer, aliasOpt("f"), displayerType(&displayers.Databases{}))
 	AddStringFlag(cmdDatabaseFork, doctl.ArgDatabaseRestoreFromClusterID, "", "", "The ID of an existing database cluster from which the new database will be forked from", requiredOpt())
 	AddStringFlag(cmdDatabaseFork, doctl.ArgDatabaseRestoreFromTimestamp, "", "", "The timestamp of an existing database cluster backup in UTC combined date and time format (2006-01-02 15:04:05 +0000 UTC). The most recent backup is used if excluded.")
 	AddBoolFlag(cmdDatabaseFork, doctl.ArgCommandWait, "", false, "A boolean that specifies whether to wait for a database to complete before returning control to the terminal")
@@ -163,6 +173,7 @@ For PostgreSQL and MySQL clusters, you can also provide a disk size in MiB to sc
 	cmd.AddCommand(databaseConfiguration())
 	cmd.AddCommand(databaseTopic())
 	cmd.AddCommand(databaseEvents())
+	cmd.AddCommand(databaseEndpoints())
 	cmd.AddCommand(databaseIndex())
 
 	return cmd
@@ -172,11 +183,26 @@ For PostgreSQL and MySQL clusters, you can also provide a disk size in MiB to sc
 
 // RunDatabaseList returns a list of database clusters.
 func RunDatabaseList(c *CmdConfig) error {
+	engine, err := c.Doit.GetString(c.NS, doctl.ArgDatabaseEngine)
+	if err != nil {
+		return err
+	}
+
 	dbs, err := c.Databases().List()
 	if err != nil {
 		return err
 	}
 
+	if engine != "" {
+		matched := make(do.Databases, 0, len(dbs))
+		for _, db := range dbs {
+			if db.EngineSlug == engine {
+				matched = append(matched, db)
+			}
+		}
+		dbs = matched
+	}
+
 	return displayDatabases(c, true, dbs...)
 }
 
@@ -779,7 +805,7 @@ To retrieve a list of database users for a database cluster, call `+"`"+`doctl d
 	cmdDatabaseUserCreate := CmdBuilder(cmd, RunDatabaseUserCreate, "create <database-cluster-id> <user-name>",
 		"Create a database user", `Creates a new user for a database. New users are given a role of `+"`"+`normal`+"`"+` and are given an automatically-generated password.
 
-To retrieve a list of your databases and their IDs, call `+"`"+`doctl databases list`+"`"+`.`, Writer, aliasOpt("c"))
+To retrieve a list of your databases and their IDs, call `+"`"+`doctl databases list`+"`"+`.`, Writer, aliasOpt("c"), displayerType(&displayers.DatabaseUsers{}))
 
 	AddStringFlag(cmdDatabaseUserCreate, doctl.ArgDatabaseUserMySQLAuthPlugin, "", "",
 		"Sets authorization plugin for a MySQL user. Possible values: `caching_sha2_password` or `mysql_native_password`")
@@ -787,8 +813,10 @@ To retrieve a list of your databases and their IDs, call `+"`"+`doctl databases
 	AddStringSliceFlag(cmdDatabaseUserCreate, doctl.ArgDatabaseUserOpenSearchACLs, "", []string{}, databaseOpenSearchACLsTxt)
 	cmdDatabaseUserCreate.Example = `The following example creates a new user with the username ` + "`" + `example-user` + "`" + ` for a database cluster with the ID ` + "`" + `ca9f591d-f38h-5555-a0ef-1c02d1d1e35` + "`" + `: doctl databases user create ca9f591d-f38h-5555-a0ef-1c02d1d1e35 example-user`
 
-	cmdDatabaseUserResetAuth := CmdBuilder(cmd, RunDatabaseUserResetAuth, "reset <database-cluster-id> <user-name> <new-auth-mode>",
-		"Resets a user's auth", "Resets the auth password or the MySQL authorization plugin for a given user and returns the user's new credentials. When resetting MySQL auth, valid values for `<new-auth-mode>` are `caching_sha2_password` and `mysql_native_password`.", Writer, aliasOpt("rs"))
+	cmdDatabaseUserResetAuth := CmdBuilder(cmd, RunDatabaseUserResetAuth, "reset <database-cluster-id> <user-name> [<new-auth-mode>]",
+		"Resets a user's auth", "Resets the auth password or the MySQL authorization plugin for a given user and returns the user's new credentials. The new password is only displayed once. Existing connections that use the old password are dropped.\n\nWhen resetting MySQL auth, set the authorization plugin with `<new-auth-mode>` or the `--mysql-auth-plugin` flag. Valid values are `caching_sha2_password` and `mysql_native_password`.", Writer, aliasOpt("rs", "reset-auth"))
+	AddStringFlag(cmdDatabaseUserResetAuth, doctl.ArgDatabaseUserMySQLAuthPlugin, "", "",
+		"Sets authorization plugin for a MySQL user. Possible values: `caching_sha2_password` or `mysql_native_password`")
 	cmdDatabaseUserResetAuth.Example = `The following example resets the auth plugin for the user with the username ` + "`" + `example-user` + "`" + ` for a database cluster with the ID ` + "`" + `ca9f591d-f38h-5555-a0ef-1c02d1d1e35` + "`" + ` to ` + "`" + `mysql_native_password` + "`" + `: doctl databases user reset ca9f591d-f38h-5555-a0ef-1c02d1d1e35 example-user mysql_native_password`
 
 	cmdDatabaseUserDelete := CmdBuilder(cmd, RunDatabaseUserDelete,
@@ -946,12 +974,19 @@ func RunDatabaseUserResetAuth(c *CmdConfig) error {
 		return err
 	}
 
+	authMode, err := c.Doit.GetString(c.NS, doctl.ArgDatabaseUserMySQLAuthPlugin)
+	if err != nil {
+		return err
+	}
+
 	var req *godo.DatabaseResetUserAuthRequest
 	if strings.ToLower(database.EngineSlug) == "mysql" {
-		if len(c.Args) < 3 {
-			return doctl.NewMissingArgsErr(c.NS)
+		if authMode == "" {
+			if len(c.Args) < 3 {
+				return doctl.NewMissingArgsErr(c.NS)
+			}
+			authMode = c.Args[2]
 		}
-		authMode := c.Args[2]
 		req = &godo.DatabaseResetUserAuthRequest{
 			MySQLSettings: &godo.DatabaseMySQLUserSettings{
 				AuthPlugin: authMode,
@@ -961,6 +996,8 @@ func RunDatabaseUserResetAuth(c *CmdConfig) error {
 		req = &godo.DatabaseResetUserAuthRequest{}
 	}
 
+	warn("Resetting the credentials of user %s drops existing connections that use the old password.", userName)
+
 	user, err := c.Databases().ResetUserAuth(databaseID, userName, req)
 	if err != nil {
 		return err
@@ -1231,7 +1268,7 @@ There’s no perfect formula to determine how large your pool should be, but the
 - A pool that’s much smaller than the number of clients communicating with the database can act as a bottleneck, reducing the rate when your database receives and responds to transactions.
 
 We recommend starting with a pool size of about half your available connections and adjusting later based on performance. If you see slow query responses, check the CPU usage on the database’s Overview tab. We recommend decreasing your pool size if CPU usage is high, and increasing your pool size if it’s low.`+getPoolDetails, Writer,
-		aliasOpt("c"))
+		aliasOpt("c"), displayerType(&displayers.DatabasePools{}))
 	AddStringFlag(cmdDatabasePoolCreate, doctl.ArgDatabasePoolMode, "",
 		"transaction", "The pool mode for the connection pool, such as `session`, `transaction`, and `statement`")
 	AddIntFlag(cmdDatabasePoolCreate, doctl.ArgSizeSlug, "", 0, "pool size",
@@ -1459,7 +1496,7 @@ You can get a list of existing databases that are hosted within a cluster by cal
 	cmdDatabaseDBGet.Example = `The following example retrieves the name of a database in a database cluster with the ID ` + "`" + `ca9f591d-f38h-5555-a0ef-1c02d1d1e35` + "`" + ` and the name ` + "`" + `example-db` + "`" + `: doctl databases db get ca9f591d-f38h-5555-a0ef-1c02d1d1e35 example-db`
 
 	cmdDatabaseDBCreate := CmdBuilder(cmd, RunDatabaseDBCreate, "create <database-cluster-id> <database-name>",
-		"Create a database within a cluster", "Creates a database with the specified name in the specified database cluster."+getClusterList, Writer, aliasOpt("c"))
+		"Create a database within a cluster", "Creates a database with the specified name in the specified database cluster."+getClusterList, Writer, aliasOpt("c"), displayerType(&displayers.DatabaseDBs{}))
 	cmdDatabaseDBCreate.Example = `The following example creates a database named ` + "`" + `example-db` + "`" + ` in a database cluster with the ID ` + "`" + `ca9f591d-f38h-5555-a0ef-1c02d1d1e35` + "`" + `: doctl databases db create ca9f591d-f38h-5555-a0ef-1c02d1d1e35 example-db`
 
 	cmdDatabaseDBDelete := CmdBuilder(cmd, RunDatabaseDBDelete,
@@ -1589,7 +1626,7 @@ This command requires that you pass in the replica's name, which you can retriev
 
 	cmdDatabaseReplicaCreate := CmdBuilder(cmd, RunDatabaseReplicaCreate,
 		"create <database-cluster-id> <replica-name>", "Create a read-only database replica", `Creates a read-only database replica for the specified database cluster, giving it the specified name.`+databaseListDetails,
-		Writer, aliasOpt("c"))
+		Writer, aliasOpt("c"), displayerType(&displayers.DatabaseReplicas{}))
 	AddStringFlag(cmdDatabaseReplicaCreate, doctl.ArgRegionSlug, "",
 		defaultDatabaseRegion, `Specifies the region in which to create the replica, such as `+"`"+`nyc3`+"`"+` or `+"`"+`sfo2`+"`"+`.`)
 	AddStringFlag(cmdDatabaseReplicaCreate, doctl.ArgSizeSlug, "",
@@ -1906,6 +1943,14 @@ func RunDatabaseTopicCreate(c *CmdConfig) error {
 	}
 	createReq.Config = getDatabaseTopicConfigArgs(c)
 
+	configPairs, err := c.Doit.GetStringSlice(c.NS, doctl.ArgDatabaseTopicConfig)
+	if err != nil {
+		return err
+	}
+	if err := applyDatabaseTopicConfigPairs(createReq.Config, configPairs); err != nil {
+		return err
+	}
+
 	_, err = c.Databases().CreateTopic(databaseID, createReq)
 	return err
 }
@@ -1932,10 +1977,60 @@ func RunDatabaseTopicUpdate(c *CmdConfig) error {
 	}
 	updateReq.Config = getDatabaseTopicConfigArgs(c)
 
+	configPairs, err := c.Doit.GetStringSlice(c.NS, doctl.ArgDatabaseTopicConfig)
+	if err != nil {
+		return err
+	}
+	if err := applyDatabaseTopicConfigPairs(updateReq.Config, configPairs); err != nil {
+		return err
+	}
+
 	err = c.Databases().UpdateTopic(databaseID, topicName, updateReq)
 	return err
 }
 
+// applyDatabaseTopicConfigPairs sets the kafka topic configuration options
+// given as key=value pairs, where each key is the API name of the option
+// (e.g. retention_ms). Values that are valid JSON literals such as numbers
+// and booleans are sent as such; anything else is sent as a string.
+func applyDatabaseTopicConfigPairs(cfg *godo.TopicConfig, pairs []string) error {
+	if len(pairs) == 0 {
+		return nil
+	}
+
+	raw := make(map[string]json.RawMessage, len(pairs))
+	for _, pair := range pairs {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok || key == "" {
+			return fmt.Errorf("unexpected input value [%v], must be a key=value pair", pair)
+		}
+
+		if json.Valid([]byte(value)) {
+			raw[key] = json.RawMessage(value)
+			continue
+		}
+
+		quoted, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		raw[key] = quoted
+	}
+
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(cfg); err != nil {
+		return fmt.Errorf("invalid topic config: %v", err)
+	}
+
+	return nil
+}
+
 func getDatabaseTopicConfigArgs(c *CmdConfig) *godo.TopicConfig {
 	res := &godo.TopicConfig{}
 	val, err := c.Doit.GetString(c.NS, doctl.ArgDatabaseTopicCleanupPolicy)
@@ -2115,7 +2210,7 @@ This command lists the following details for each partition of a given topic in
 	cmdDatabaseTopicDelete := CmdBuilder(cmd, RunDatabaseTopicDelete, "delete <database-uuid> <topic-name>", "Deletes a kafka topic by topic name", "", Writer, aliasOpt("rm"))
 	AddBoolFlag(cmdDatabaseTopicDelete, doctl.ArgForce, doctl.ArgShortForce, false, "Deletes the kafka topic without a confirmation prompt")
 	cmdDatabaseTopicCreate := CmdBuilder(cmd, RunDatabaseTopicCreate, "create <database-uuid> <topic-name>", "Creates a topic for a given kafka database",
-		"This command creates a kafka topic for the specified kafka database cluster, giving it the specified name. Example: doctl databases topics create <database-uuid> <topic-name> --replication_factor 2 --partition_count 4", Writer, aliasOpt("c"))
+		"This command creates a kafka topic for the specified kafka database cluster, giving it the specified name. Example: doctl databases topics create <database-uuid> <topic-name> --replication-factor 2 --partition-count 4 --topic-config retention_ms=86400000", Writer, aliasOpt("c"))
 	cmdDatabaseTopicUpdate := CmdBuilder(cmd, RunDatabaseTopicUpdate, "update <database-uuid> <topic-name>", "Updates a topic for a given kafka database",
 		"This command updates a kafka topic for the specified kafka database cluster. Example: doctl databases topics update <database-uuid> <topic-name>", Writer, aliasOpt("u"))
 	cmdsWithConfig := []*Command{cmdDatabaseTopicCreate, cmdDatabaseTopicUpdate}
@@ -2164,6 +2259,8 @@ This command lists the following details for each partition of a given topic in
 			"Specifies the maximum time (in ms) for random jitter that is subtracted from the scheduled segment roll time to avoid thundering herd problems")
 		AddStringFlag(c, doctl.ArgDatabaseTopicSegmentMS, "", "",
 			"Specifies the maximum time (in ms) to wait to force a log roll if the segment file isn't full. After this period, the log will be forced to roll")
+		AddStringSliceFlag(c, doctl.ArgDatabaseTopicConfig, "", []string{},
+			"Sets advanced topic configuration as `key=value` pairs using the API option names, e.g. `retention_ms=86400000`. Takes precedence over the individual configuration flags. Can be repeated")
 	}
 	return cmd
 }
@@ -2764,6 +2861,69 @@ func RunDatabaseEvents(c *CmdConfig) error {
 	return c.Display(item)
 }
 
+func databaseEndpoints() *Command {
+	cmd := &Command{
+		Command: &cobra.Command{
+			Use:     "endpoints",
+			Aliases: []string{"ep"},
+			Short:   "Display commands for viewing database cluster connection endpoints",
+			Long: `The subcommands under ` + "`" + `doctl databases endpoints` + "`" + ` are for viewing the hosts a database cluster accepts connections on.
+
+Endpoints are created and removed by DigitalOcean along with the cluster, its standby nodes, and its VPC network; they cannot be added or removed individually.`,
+		},
+	}
+	cmdDatabaseEndpointsList := CmdBuilder(cmd, RunDatabaseEndpointsList, "list <database-cluster-id>", "List the connection endpoints of a database cluster", `Lists the public and private connection endpoints of a database cluster, including those of its standby nodes and, for clusters that have one, its web UI. For each endpoint, the host, port, and whether SSL is required are shown.
+
+To retrieve full connection details, including credentials, use `+"`"+`doctl databases connection`+"`"+`.`, Writer, aliasOpt("ls"), displayerType(&displayers.DatabaseEndpoints{}))
+	cmdDatabaseEndpointsList.Example = `The following example lists the connection endpoints of a database cluster with the ID ` + "`" + `ca9f591d-f38h-5555-a0ef-1c02d1d1e35` + "`" + `: doctl databases endpoints list ca9f591d-f38h-5555-a0ef-1c02d1d1e35`
+
+	return cmd
+}
+
+// RunDatabaseEndpointsList lists the connection endpoints of a database cluster.
+func RunDatabaseEndpointsList(c *CmdConfig) error {
+	if len(c.Args) == 0 {
+		return doctl.NewMissingArgsErr(c.NS)
+	}
+
+	db, err := c.Databases().Get(c.Args[0])
+	if err != nil {
+		return err
+	}
+
+	item := &displayers.DatabaseEndpoints{DatabaseEndpoints: collectDatabaseEndpoints(db)}
+	return c.Display(item)
+}
+
+// collectDatabaseEndpoints gathers the connection endpoints reported for a
+// cluster.
+func collectDatabaseEndpoints(db *do.Database) []displayers.DatabaseEndpoint {
+	conns := []struct {
+		kind string
+		conn *godo.DatabaseConnection
+	}{
+		{"public", db.Connection},
+		{"private", db.PrivateConnection},
+		{"standby public", db.StandbyConnection},
+		{"standby private", db.StandbyPrivateConnection},
+		{"ui", db.UIConnection},
+	}
+
+	endpoints := make([]displayers.DatabaseEndpoint, 0, len(conns))
+	for _, c := range conns {
+		if c.conn == nil || c.conn.Host == "" {
+			continue
+		}
+		endpoints = append(endpoints, displayers.DatabaseEndpoint{
+			Type: c.kind,
+			Host: c.conn.Host,
+			Port: c.conn.Port,
+			SSL:  c.conn.SSL,
+		})
+	}
+	return endpoints
+}
+
 func databaseIndex() *Command {
 	cmd := &Command{
 		Command: &cobra.Command{
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"bytes"
 	"errors"
 	"testing"
 	"time"
@@ -282,9 +283,30 @@ func TestDatabasesCommand(t *testing.T) {
 		"configuration",
 		"topics",
 		"indexes",
+		"endpoints",
 	)
 }
 
+func TestDatabaseEndpointsList(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		db := do.Database{Database: &godo.Database{
+			ID:                "ea4652de-4fe0-11e9-b7ab-df1ef30eab9e",
+			Connection:        &godo.DatabaseConnection{Host: "db.example.com", Port: 25060, SSL: true},
+			PrivateConnection: &godo.DatabaseConnection{Host: "private-db.example.com", Port: 25060, SSL: true},
+		}}
+		tm.databases.EXPECT().Get(db.ID).Return(&db, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Args = append(config.Args, db.ID)
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := RunDatabaseEndpointsList(config)
+		assert.NoError(t, err)
+		assert.Equal(t, "public     db.example.com            25060    true\nprivate    private-db.example.com    25060    true\n", buf.String())
+	})
+}
+
 func TestDatabaseMaintenanceWindowCommand(t *testing.T) {
 	cmd := databaseMaintenanceWindow()
 	assert.NotNil(t, cmd)
@@ -382,6 +404,19 @@ func TestDatabasesGet(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	// High availability is shown next to the node count, as in the list
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.databases.EXPECT().Get(testDBCluster.ID).Return(&testDBCluster, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Args = append(config.Args, testDBCluster.ID)
+
+		err := RunDatabaseGet(config)
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), "Number of Nodes    High Availability    Region")
+	})
+
 	// Error
 	notFound := "not-found"
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
@@ -439,6 +474,66 @@ func TestDatabasesList(t *testing.T) {
 	})
 }
 
+func TestDatabasesListMonitoringURL(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.databases.EXPECT().List().Return(do.Databases{testDBCluster}, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Doit.Set(config.NS, doctl.ArgFormat, "Name,MonitoringURL")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := RunDatabaseList(config)
+		assert.NoError(t, err)
+		assert.Equal(t, "sunny-db-cluster    https://cloud.digitalocean.com/databases/ea4652de-4fe0-11e9-b7ab-df1ef30eab9e/insights\n", buf.String())
+	})
+}
+
+func TestDatabasesListHighAvailability(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		single := *testDBCluster.Database
+		single.Name = "single"
+		single.NumNodes = 1
+		standby := *testDBCluster.Database
+		standby.Name = "standby"
+		standby.NumNodes = 3
+		kafka := *testDBCluster.Database
+		kafka.Name = "kafka"
+		kafka.EngineSlug = "kafka"
+		kafka.NumNodes = 3
+		tm.databases.EXPECT().List().Return(do.Databases{{Database: &single}, {Database: &standby}, {Database: &kafka}}, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Doit.Set(config.NS, doctl.ArgFormat, "Name,HighAvailability")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := RunDatabaseList(config)
+		assert.NoError(t, err)
+		assert.Equal(t, "single     false\nstandby    true\nkafka      N/A\n", buf.String())
+	})
+}
+
+func TestDatabasesListEngine(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		mysqlCluster := *testDBCluster.Database
+		mysqlCluster.Name = "mysql-cluster"
+		mysqlCluster.EngineSlug = "mysql"
+		mysql := do.Database{Database: &mysqlCluster}
+		tm.databases.EXPECT().List().Return(do.Databases{testDBCluster, mysql}, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Doit.Set(config.NS, doctl.ArgDatabaseEngine, "mysql")
+		config.Doit.Set(config.NS, doctl.ArgFormat, "Name")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := RunDatabaseList(config)
+		assert.NoError(t, err)
+		assert.Equal(t, "mysql-cluster\n", buf.String())
+	})
+}
+
 func TestDatabasesCreate(t *testing.T) {
 	r := &godo.DatabaseCreateRequest{
 		Name:               testDBCluster.Name,
@@ -767,6 +862,34 @@ func TestDatabaseCreateKafkaTopic(t *testing.T) {
 		err := RunDatabaseTopicCreate(config)
 		assert.NoError(t, err)
 	})
+	// Success - with topic config pairs
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		retentionMS := int64(86400000)
+		preallocate := true
+		createReq := &godo.DatabaseCreateTopicRequest{
+			Name: testKafkaTopic.Name,
+			Config: &godo.TopicConfig{
+				CleanupPolicy: "compact",
+				RetentionMS:   &retentionMS,
+				Preallocate:   &preallocate,
+			},
+		}
+		tm.databases.EXPECT().CreateTopic(testKafkaDBCluster.ID, createReq).Return(&testKafkaTopic, nil)
+		config.Args = append(config.Args, testKafkaDBCluster.ID, testKafkaTopic.Name)
+		config.Doit.Set(config.NS, doctl.ArgDatabaseTopicCleanupPolicy, "delete")
+		config.Doit.Set(config.NS, doctl.ArgDatabaseTopicConfig, []string{"cleanup_policy=compact", "retention_ms=86400000", "preallocate=true"})
+
+		err := RunDatabaseTopicCreate(config)
+		assert.NoError(t, err)
+	})
+	// Error - unknown topic config key
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Args = append(config.Args, testKafkaDBCluster.ID, testKafkaTopic.Name)
+		config.Doit.Set(config.NS, doctl.ArgDatabaseTopicConfig, []string{"bogus_option=1"})
+
+		err := RunDatabaseTopicCreate(config)
+		assert.Error(t, err)
+	})
 	// Error
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		tm.databases.EXPECT().CreateTopic(testKafkaDBCluster.ID, gomock.AssignableToTypeOf(&godo.DatabaseCreateTopicRequest{})).Return(nil, errTest)
@@ -1116,6 +1239,29 @@ func TestDatabaseResetUserAuth(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	// Successful mysql call with the auth plugin flag
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		r := &godo.DatabaseResetUserAuthRequest{
+			MySQLSettings: &godo.DatabaseMySQLUserSettings{
+				AuthPlugin: godo.SQLAuthPluginNative,
+			},
+		}
+
+		mysqlTestDb := *testDBCluster.Database
+		mysqlTestDb.EngineSlug = "mysql"
+
+		tm.databases.EXPECT().Get(testDBCluster.ID).Return(&do.Database{Database: &mysqlTestDb}, nil)
+		tm.databases.EXPECT().ResetUserAuth(testDBCluster.ID, testDBUser.Name, r).Return(&testDBUser, nil)
+
+		config.Args = append(config.Args, testDBCluster.ID, testDBUser.Name)
+		config.Doit.Set(config.NS, doctl.ArgDatabaseUserMySQLAuthPlugin, godo.SQLAuthPluginNative)
+
+		var err error
+		warnings := captureStderr(t, func() { err = RunDatabaseUserResetAuth(config) })
+		assert.NoError(t, err)
+		assert.Contains(t, warnings, "drops existing connections that use the old password")
+	})
+
 	// Successful pg call
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		r := &godo.DatabaseResetUserAuthRequest{}
@@ -1138,8 +1284,10 @@ func TestDatabaseResetUserAuth(t *testing.T) {
 		).Return(nil, errTest)
 
 		config.Args = append(config.Args, testDBCluster.ID, testDBUser.Name, godo.SQLAuthPluginNative)
-		err := RunDatabaseUserResetAuth(config)
+		var err error
+		warnings := captureStderr(t, func() { err = RunDatabaseUserResetAuth(config) })
 		assert.EqualError(t, err, "error")
+		assert.Contains(t, warnings, "drops existing connections that use the old password")
 	})
 }
 
@@ -294,6 +294,8 @@ func (is AppInstanceSizes) JSON(w io.Writer) error {
 
 type AppProposeResponse struct {
 	Res *godo.AppProposeResponse
+	// HideCost omits the monthly cost estimates from the output.
+	HideCost bool
 }
 
 var _ Displayable = (*AppProposeResponse)(nil)
@@ -307,13 +309,11 @@ func (r AppProposeResponse) Cols() []string {
 		cols = append(cols, "AppNameSuggestion")
 	}
 
-	cols = append(cols, []string{
-		"AppIsStatic",
-		"StaticApps",
-		"AppCost",
-		"AppTierUpgradeCost",
-		"AppTierDowngradeCost",
-	}...)
+	cols = append(cols, "AppIsStatic", "StaticApps")
+
+	if !r.HideCost {
+		cols = append(cols, "AppCost", "AppTierUpgradeCost", "AppTierDowngradeCost")
+	}
 
 	return cols
 }
@@ -372,9 +372,18 @@ func (r AppProposeResponse) KV() []map[string]any {
 }
 
 func (r AppProposeResponse) JSON(w io.Writer) error {
+	res := r.Res
+	if r.HideCost {
+		withoutCost := *r.Res
+		withoutCost.AppCost = 0
+		withoutCost.AppTierUpgradeCost = 0
+		withoutCost.AppTierDowngradeCost = 0
+		res = &withoutCost
+	}
+
 	e := json.NewEncoder(w)
 	e.SetIndent("", "  ")
-	return e.Encode(r.Res)
+	return e.Encode(res)
 }
 
 type AppAlerts []*godo.AppAlert
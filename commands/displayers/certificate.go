@@ -16,15 +16,22 @@ package displayers
 import (
 	"io"
 	"strings"
+	"time"
 
 	"github.com/digitalocean/doctl/do"
+	"github.com/fatih/color"
 )
 
+// certificateExpiryWarning is how long before its expiration date a
+// certificate is highlighted as expiring soon.
+const certificateExpiryWarning = 30 * 24 * time.Hour
+
 type Certificate struct {
 	Certificates do.Certificates
 }
 
 var _ Displayable = &Certificate{}
+var _ Highlighter = &Certificate{}
 
 func (c *Certificate) JSON(out io.Writer) error {
 	return writeJSON(c.Certificates, out)
@@ -75,3 +82,21 @@ func (c *Certificate) KV() []map[string]any {
 
 	return out
 }
+
+// Highlight colors expired certificates red and certificates that expire
+// within 30 days yellow.
+func (c *Certificate) Highlight(i int) *color.Color {
+	notAfter, err := time.Parse(time.RFC3339, c.Certificates[i].NotAfter)
+	if err != nil {
+		return nil
+	}
+
+	switch remaining := time.Until(notAfter); {
+	case remaining <= 0:
+		return color.New(color.FgRed)
+	case remaining <= certificateExpiryWarning:
+		return color.New(color.FgYellow)
+	default:
+		return nil
+	}
+}
@@ -42,10 +42,13 @@ func (d *Databases) Cols() []string {
 			"Engine",
 			"Version",
 			"NumNodes",
+			"HighAvailability",
 			"Region",
 			"Status",
 			"Size",
 			"StorageMib",
+			"MaintenanceWindowDay",
+			"MaintenanceWindowHour",
 		}
 	}
 
@@ -55,28 +58,35 @@ func (d *Databases) Cols() []string {
 		"Engine",
 		"Version",
 		"NumNodes",
+		"HighAvailability",
 		"Region",
 		"Status",
 		"Size",
 		"URI",
 		"Created",
 		"StorageMib",
+		"MaintenanceWindowDay",
+		"MaintenanceWindowHour",
 	}
 }
 
 func (d *Databases) ColMap() map[string]string {
 	return map[string]string{
-		"ID":         "ID",
-		"Name":       "Name",
-		"Engine":     "Engine",
-		"Version":    "Version",
-		"NumNodes":   "Number of Nodes",
-		"Region":     "Region",
-		"Status":     "Status",
-		"Size":       "Size",
-		"StorageMib": "Storage (MiB)",
-		"URI":        "URI",
-		"Created":    "Created At",
+		"ID":                    "ID",
+		"Name":                  "Name",
+		"Engine":                "Engine",
+		"Version":               "Version",
+		"NumNodes":              "Number of Nodes",
+		"HighAvailability":      "High Availability",
+		"Region":                "Region",
+		"Status":                "Status",
+		"Size":                  "Size",
+		"StorageMib":            "Storage (MiB)",
+		"URI":                   "URI",
+		"Created":               "Created At",
+		"MaintenanceWindowDay":  "Maintenance Day",
+		"MaintenanceWindowHour": "Maintenance Hour",
+		"MonitoringURL":         "Monitoring URL",
 	}
 }
 
@@ -84,18 +94,28 @@ func (d *Databases) KV() []map[string]any {
 	out := make([]map[string]any, 0, len(d.Databases))
 
 	for _, db := range d.Databases {
+		var maintenanceDay, maintenanceHour string
+		if db.MaintenanceWindow != nil {
+			maintenanceDay = db.MaintenanceWindow.Day
+			maintenanceHour = db.MaintenanceWindow.Hour
+		}
+
 		o := map[string]any{
-			"ID":         db.ID,
-			"Name":       db.Name,
-			"Engine":     db.EngineSlug,
-			"Version":    db.VersionSlug,
-			"NumNodes":   db.NumNodes,
-			"Region":     db.RegionSlug,
-			"Status":     db.Status,
-			"Size":       db.SizeSlug,
-			"StorageMib": db.StorageSizeMib,
-			"URI":        db.Connection.URI,
-			"Created":    db.CreatedAt,
+			"ID":                    db.ID,
+			"Name":                  db.Name,
+			"Engine":                db.EngineSlug,
+			"Version":               db.VersionSlug,
+			"NumNodes":              db.NumNodes,
+			"HighAvailability":      databaseHighAvailability(db),
+			"Region":                db.RegionSlug,
+			"Status":                db.Status,
+			"Size":                  db.SizeSlug,
+			"StorageMib":            db.StorageSizeMib,
+			"URI":                   db.Connection.URI,
+			"Created":               db.CreatedAt,
+			"MaintenanceWindowDay":  maintenanceDay,
+			"MaintenanceWindowHour": maintenanceHour,
+			"MonitoringURL":         databaseMonitoringURL(db.ID),
 		}
 		out = append(out, o)
 	}
@@ -103,6 +123,24 @@ func (d *Databases) KV() []map[string]any {
 	return out
 }
 
+// databaseHighAvailability reports whether a database cluster has standby
+// nodes. The API does not return them separately, so any node beyond the
+// primary is counted as one. Kafka and OpenSearch nodes are not standbys, so
+// N/A is returned for them.
+func databaseHighAvailability(db do.Database) any {
+	switch db.EngineSlug {
+	case "kafka", "opensearch":
+		return "N/A"
+	}
+	return db.NumNodes > 1
+}
+
+// databaseMonitoringURL returns the control panel URL of the insights page for
+// a database cluster.
+func databaseMonitoringURL(id string) string {
+	return "https://cloud.digitalocean.com/databases/" + id + "/insights"
+}
+
 type DatabaseBackups struct {
 	DatabaseBackups do.DatabaseBackups
 }
@@ -2335,6 +2373,58 @@ func (dr *DatabaseEvents) KV() []map[string]any {
 	return out
 }
 
+// DatabaseEndpoint describes one of the hosts a database cluster accepts
+// connections on.
+type DatabaseEndpoint struct {
+	Type string `json:"type"`
+	Host string `json:"host"`
+	Port int    `json:"port"`
+	SSL  bool   `json:"ssl"`
+}
+
+type DatabaseEndpoints struct {
+	DatabaseEndpoints []DatabaseEndpoint
+}
+
+var _ Displayable = &DatabaseEndpoints{}
+
+func (de *DatabaseEndpoints) JSON(out io.Writer) error {
+	return writeJSON(de.DatabaseEndpoints, out)
+}
+
+func (de *DatabaseEndpoints) Cols() []string {
+	return []string{
+		"Type",
+		"Host",
+		"Port",
+		"SSL",
+	}
+}
+
+func (de *DatabaseEndpoints) ColMap() map[string]string {
+	return map[string]string{
+		"Type": "Type",
+		"Host": "Host",
+		"Port": "Port",
+		"SSL":  "SSL",
+	}
+}
+
+func (de *DatabaseEndpoints) KV() []map[string]any {
+	out := make([]map[string]any, 0, len(de.DatabaseEndpoints))
+
+	for _, e := range de.DatabaseEndpoints {
+		o := map[string]any{
+			"Type": e.Type,
+			"Host": e.Host,
+			"Port": e.Port,
+			"SSL":  e.SSL,
+		}
+		out = append(out, o)
+	}
+	return out
+}
+
 type DatabaseOpenSearchIndexes struct {
 	DatabaseIndexes do.DatabaseIndexes
 }
@@ -15,12 +15,16 @@ package displayers
 
 import (
 	"io"
+	"strings"
 
 	"github.com/digitalocean/doctl/do"
 )
 
 type Domain struct {
 	Domains do.Domains
+	// Records holds the DNS records of each domain, keyed by domain name.
+	// When set, the RecordCount and NSServers columns are displayed.
+	Records map[string]do.DomainRecords
 }
 
 var _ Displayable = &Domain{}
@@ -30,12 +34,19 @@ func (d *Domain) JSON(out io.Writer) error {
 }
 
 func (d *Domain) Cols() []string {
-	return []string{"Domain", "TTL"}
+	cols := []string{"Domain", "TTL"}
+
+	if d.Records != nil {
+		cols = append(cols, "RecordCount", "NSServers")
+	}
+
+	return cols
 }
 
 func (d *Domain) ColMap() map[string]string {
 	return map[string]string{
 		"Domain": "Domain", "TTL": "TTL",
+		"RecordCount": "Record Count", "NSServers": "NS Servers",
 	}
 }
 
@@ -46,6 +57,18 @@ func (d *Domain) KV() []map[string]any {
 		o := map[string]any{
 			"Domain": do.Name, "TTL": do.TTL,
 		}
+
+		if records, ok := d.Records[do.Name]; ok {
+			var nsServers []string
+			for _, r := range records {
+				if r.Type == "NS" {
+					nsServers = append(nsServers, r.Data)
+				}
+			}
+			o["RecordCount"] = len(records)
+			o["NSServers"] = strings.Join(nsServers, ",")
+		}
+
 		out = append(out, o)
 	}
 
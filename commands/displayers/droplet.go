@@ -16,16 +16,37 @@ package displayers
 import (
 	"fmt"
 	"io"
+	"slices"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/digitalocean/doctl/do"
+	"github.com/digitalocean/godo"
 )
 
 type Droplet struct {
 	Droplets do.Droplets
+	// VolumeNames maps volume IDs to volume names. When set, the Volumes
+	// column displays each attached volume as <name>(<id>).
+	VolumeNames map[string]string
+	// BackupPolicies maps Droplet IDs to their backup policies. It is used to
+	// fill the BackupPolicy column.
+	BackupPolicies map[int]do.DropletBackupPolicy
+	// MaxTags limits the number of tags shown in the Tags column. Further
+	// tags are replaced by "...". Zero shows all tags.
+	MaxTags int
+	// ShowConsoleURL adds the ConsoleURL column to the default columns.
+	ShowConsoleURL bool
+	// MetricsAgents reports whether the metrics agent (do-agent) is sending
+	// metrics from each Droplet. When set, the MetricsAgent column is added to
+	// the default columns.
+	MetricsAgents map[int]bool
 }
 
+// dropletConsoleURL is the control panel page of a Droplet.
+const dropletConsoleURL = "https://cloud.digitalocean.com/droplets/%d"
+
 var _ Displayable = &Droplet{}
 
 func (d *Droplet) JSON(out io.Writer) error {
@@ -36,6 +57,12 @@ func (d *Droplet) Cols() []string {
 	cols := []string{
 		"ID", "Name", "PublicIPv4", "PrivateIPv4", "PublicIPv6", "Memory", "VCPUs", "Disk", "Region", "Image", "VPCUUID", "Status", "Tags", "Features", "Volumes",
 	}
+	if d.ShowConsoleURL {
+		cols = append(cols, "ConsoleURL")
+	}
+	if d.MetricsAgents != nil {
+		cols = append(cols, "MetricsAgent")
+	}
 	return cols
 }
 
@@ -45,30 +72,89 @@ func (d *Droplet) ColMap() map[string]string {
 		"Memory": "Memory", "VCPUs": "VCPUs", "Disk": "Disk",
 		"Region": "Region", "Image": "Image", "VPCUUID": "VPC UUID", "Status": "Status",
 		"Tags": "Tags", "Features": "Features", "Volumes": "Volumes",
-		"SizeSlug": "Size Slug",
+		"SizeSlug": "Size Slug", "BackupEnabled": "Backup Enabled", "BackupPolicy": "Backup Policy", "NextBackupWindow": "Next Backup Window",
+		"ConsoleURL": "Console URL", "MetricsAgent": "Metrics Agent",
+		"CreatedAt": "Created At",
 	}
 }
 
 func (d *Droplet) KV() []map[string]any {
 	out := make([]map[string]any, 0, len(d.Droplets))
+	volumeNames := d.VolumeNames
+	backupPolicies := d.BackupPolicies
+	metricsAgents := d.MetricsAgents
+	maxTags := d.MaxTags
 	for _, d := range d.Droplets {
 		sort.Strings(d.Tags)
 		tags := strings.Join(d.Tags, ",")
+		if maxTags > 0 && len(d.Tags) > maxTags {
+			tags = strings.Join(d.Tags[:maxTags], ",") + ",..."
+		}
 		image := fmt.Sprintf("%s %s", d.Image.Distribution, d.Image.Name)
 		ip, _ := d.PublicIPv4()
 		privIP, _ := d.PrivateIPv4()
 		ip6, _ := d.PublicIPv6()
 		features := strings.Join(d.Features, ",")
 		volumes := strings.Join(d.VolumeIDs, ",")
+		if volumeNames != nil {
+			named := make([]string, 0, len(d.VolumeIDs))
+			for _, id := range d.VolumeIDs {
+				named = append(named, fmt.Sprintf("%s(%s)", volumeNames[id], id))
+			}
+			volumes = strings.Join(named, ",")
+		}
+		var backupPolicy string
+		if policy, ok := backupPolicies[d.ID]; ok {
+			backupPolicy = formatBackupPolicy(policy.BackupPolicy)
+		}
 		m := map[string]any{
 			"ID": d.ID, "Name": d.Name, "PublicIPv4": ip, "PrivateIPv4": privIP, "PublicIPv6": ip6,
 			"Memory": d.Memory, "VCPUs": d.Vcpus, "Disk": d.Disk,
 			"Region": d.Region.Slug, "Image": image, "VPCUUID": d.VPCUUID, "Status": d.Status,
 			"Tags": tags, "Features": features, "Volumes": volumes,
-			"SizeSlug": d.SizeSlug,
+			"SizeSlug": d.SizeSlug, "BackupEnabled": slices.Contains(d.Features, "backups"), "BackupPolicy": backupPolicy,
+			"NextBackupWindow": formatBackupWindow(d.NextBackupWindow),
+			"ConsoleURL":       fmt.Sprintf(dropletConsoleURL, d.ID),
+			"MetricsAgent":     "N/A",
+			"CreatedAt":        formatDropletCreated(d.Created),
+		}
+		if metricsAgents[d.ID] {
+			m["MetricsAgent"] = "reporting"
 		}
 		out = append(out, m)
 	}
 
 	return out
 }
+
+// formatDropletCreated returns the creation time of a Droplet as a UTC
+// timestamp such as "2024-01-15 10:30:00". Unparsable values are returned
+// unchanged.
+func formatDropletCreated(created string) string {
+	t, err := time.Parse(time.RFC3339, created)
+	if err != nil {
+		return created
+	}
+	return t.UTC().Format(time.DateTime)
+}
+
+// formatBackupPolicy returns a short description of a backup policy such as
+// "weekly SUN 08:00 UTC".
+func formatBackupPolicy(p *godo.DropletBackupPolicyConfig) string {
+	if p == nil {
+		return ""
+	}
+	parts := []string{p.Plan}
+	if p.Weekday != "" {
+		parts = append(parts, p.Weekday)
+	}
+	parts = append(parts, fmt.Sprintf("%02d:00 UTC", p.Hour))
+	return strings.Join(parts, " ")
+}
+
+func formatBackupWindow(w *godo.BackupWindow) string {
+	if w == nil || w.Start == nil || w.End == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s - %s", w.Start, w.End)
+}
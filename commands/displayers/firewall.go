@@ -38,9 +38,12 @@ func (f *Firewall) Cols() []string {
 		"Name",
 		"Status",
 		"Created",
+		"InboundRuleCount",
+		"OutboundRuleCount",
 		"InboundRules",
 		"OutboundRules",
 		"DropletIDs",
+		"DropletsCount",
 		"Tags",
 		"PendingChanges",
 	}
@@ -48,15 +51,18 @@ func (f *Firewall) Cols() []string {
 
 func (f *Firewall) ColMap() map[string]string {
 	return map[string]string{
-		"ID":             "ID",
-		"Name":           "Name",
-		"Status":         "Status",
-		"Created":        "Created At",
-		"InboundRules":   "Inbound Rules",
-		"OutboundRules":  "Outbound Rules",
-		"DropletIDs":     "Droplet IDs",
-		"Tags":           "Tags",
-		"PendingChanges": "Pending Changes",
+		"ID":                "ID",
+		"Name":              "Name",
+		"Status":            "Status",
+		"Created":           "Created At",
+		"InboundRuleCount":  "Inbound Rule Count",
+		"OutboundRuleCount": "Outbound Rule Count",
+		"InboundRules":      "Inbound Rules",
+		"OutboundRules":     "Outbound Rules",
+		"DropletIDs":        "Droplet IDs",
+		"DropletsCount":     "Droplets Count",
+		"Tags":              "Tags",
+		"PendingChanges":    "Pending Changes",
 	}
 }
 
@@ -66,15 +72,18 @@ func (f *Firewall) KV() []map[string]any {
 	for _, fw := range f.Firewalls {
 		irs, ors := firewallRulesPrintHelper(fw)
 		o := map[string]any{
-			"ID":             fw.ID,
-			"Name":           fw.Name,
-			"Status":         fw.Status,
-			"Created":        fw.Created,
-			"InboundRules":   irs,
-			"OutboundRules":  ors,
-			"DropletIDs":     dropletListHelper(fw.DropletIDs),
-			"Tags":           strings.Join(fw.Tags, ","),
-			"PendingChanges": firewallPendingChangesPrintHelper(fw),
+			"ID":                fw.ID,
+			"Name":              fw.Name,
+			"Status":            fw.Status,
+			"Created":           fw.Created,
+			"InboundRuleCount":  len(fw.InboundRules),
+			"OutboundRuleCount": len(fw.OutboundRules),
+			"InboundRules":      irs,
+			"OutboundRules":     ors,
+			"DropletIDs":        dropletListHelper(fw.DropletIDs),
+			"DropletsCount":     len(fw.DropletIDs),
+			"Tags":              strings.Join(fw.Tags, ","),
+			"PendingChanges":    firewallPendingChangesPrintHelper(fw),
 		}
 		out = append(out, o)
 	}
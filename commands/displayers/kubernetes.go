@@ -12,15 +12,45 @@ import (
 type KubernetesClusters struct {
 	KubernetesClusters do.KubernetesClusters
 	Short              bool
+	// UpgradeAvailable maps cluster IDs to whether a newer Kubernetes version
+	// is available. When set, an UpgradeAvailable column is added.
+	UpgradeAvailable map[string]bool
+	// Events maps cluster IDs to their recent status messages. When set, the
+	// JSON output includes an events field for each cluster.
+	Events map[string][]*godo.KubernetesClusterStatusMessage
 }
 
 var _ Displayable = &KubernetesClusters{}
 
 func (clusters *KubernetesClusters) JSON(out io.Writer) error {
-	return writeJSON(clusters.KubernetesClusters, out)
+	if clusters.Events == nil {
+		return writeJSON(clusters.KubernetesClusters, out)
+	}
+
+	type clusterWithEvents struct {
+		*godo.KubernetesCluster
+		Events []*godo.KubernetesClusterStatusMessage `json:"events"`
+	}
+	list := make([]clusterWithEvents, 0, len(clusters.KubernetesClusters))
+	for _, c := range clusters.KubernetesClusters {
+		events := clusters.Events[c.ID]
+		if events == nil {
+			events = []*godo.KubernetesClusterStatusMessage{}
+		}
+		list = append(list, clusterWithEvents{KubernetesCluster: c.KubernetesCluster, Events: events})
+	}
+	return writeJSON(list, out)
 }
 
 func (clusters *KubernetesClusters) Cols() []string {
+	cols := clusters.cols()
+	if clusters.UpgradeAvailable != nil {
+		cols = append(cols, "UpgradeAvailable")
+	}
+	return cols
+}
+
+func (clusters *KubernetesClusters) cols() []string {
 	if clusters.Short {
 		return []string{
 			"ID",
@@ -30,6 +60,7 @@ func (clusters *KubernetesClusters) Cols() []string {
 			"AutoUpgrade",
 			"Status",
 			"NodePools",
+			"NodeCount",
 		}
 	}
 	return []string{
@@ -48,6 +79,7 @@ func (clusters *KubernetesClusters) Cols() []string {
 		"Created",
 		"Updated",
 		"NodePools",
+		"NodeCount",
 		"Autoscaler.UtilizationThreshold",
 		"Autoscaler.UnneededTime",
 		"Autoscaler.Expanders",
@@ -60,13 +92,15 @@ func (clusters *KubernetesClusters) Cols() []string {
 func (clusters *KubernetesClusters) ColMap() map[string]string {
 	if clusters.Short {
 		return map[string]string{
-			"ID":          "ID",
-			"Name":        "Name",
-			"Region":      "Region",
-			"Version":     "Version",
-			"AutoUpgrade": "Auto Upgrade",
-			"Status":      "Status",
-			"NodePools":   "Node Pools",
+			"ID":               "ID",
+			"Name":             "Name",
+			"Region":           "Region",
+			"Version":          "Version",
+			"AutoUpgrade":      "Auto Upgrade",
+			"Status":           "Status",
+			"NodePools":        "Node Pools",
+			"NodeCount":        "Node Count",
+			"UpgradeAvailable": "Upgrade Available",
 		}
 	}
 	return map[string]string{
@@ -85,6 +119,8 @@ func (clusters *KubernetesClusters) ColMap() map[string]string {
 		"Created":                           "Created At",
 		"Updated":                           "Updated At",
 		"NodePools":                         "Node Pools",
+		"NodeCount":                         "Node Count",
+		"UpgradeAvailable":                  "Upgrade Available",
 		"Autoscaler.UtilizationThreshold":   "Autoscaler Scale Down Utilization",
 		"Autoscaler.UnneededTime":           "Autoscaler Scale Down Unneeded Time",
 		"Autoscaler.Expanders":              "Autoscaler Custom Expanders",
@@ -100,8 +136,10 @@ func (clusters *KubernetesClusters) KV() []map[string]any {
 	for _, cluster := range clusters.KubernetesClusters {
 		tags := strings.Join(cluster.Tags, ",")
 		nodePools := make([]string, 0, len(cluster.NodePools))
+		var nodeCount int
 		for _, pool := range cluster.NodePools {
 			nodePools = append(nodePools, pool.Name)
+			nodeCount += pool.Count
 		}
 		if cluster.Status == nil {
 			cluster.Status = new(godo.KubernetesClusterStatus)
@@ -123,6 +161,7 @@ func (clusters *KubernetesClusters) KV() []map[string]any {
 			"Created":                           cluster.CreatedAt,
 			"Updated":                           cluster.UpdatedAt,
 			"NodePools":                         strings.Join(nodePools, " "),
+			"NodeCount":                         nodeCount,
 			"Autoscaler.UtilizationThreshold":   "",
 			"Autoscaler.UnneededTime":           "",
 			"Autoscaler.Expanders":              "",
@@ -142,6 +181,9 @@ func (clusters *KubernetesClusters) KV() []map[string]any {
 				o["Autoscaler.Expanders"] = strings.Join(cfg.Expanders, ", ")
 			}
 		}
+		if clusters.UpgradeAvailable != nil {
+			o["UpgradeAvailable"] = clusters.UpgradeAvailable[cluster.ID]
+		}
 
 		out = append(out, o)
 	}
@@ -151,6 +193,8 @@ func (clusters *KubernetesClusters) KV() []map[string]any {
 
 type KubernetesNodePools struct {
 	KubernetesNodePools do.KubernetesNodePools
+	// ShowNodeStatus adds the NodeStatus column to the default columns.
+	ShowNodeStatus bool
 }
 
 var _ Displayable = &KubernetesNodePools{}
@@ -160,28 +204,35 @@ func (nodePools *KubernetesNodePools) JSON(out io.Writer) error {
 }
 
 func (nodePools *KubernetesNodePools) Cols() []string {
-	return []string{
+	cols := []string{
 		"ID",
 		"Name",
 		"Size",
 		"Count",
+		"AutoScale",
 		"Tags",
 		"Labels",
 		"Taints",
 		"Nodes",
 	}
+	if nodePools.ShowNodeStatus {
+		cols = append(cols, "NodeStatus")
+	}
+	return cols
 }
 
 func (nodePools *KubernetesNodePools) ColMap() map[string]string {
 	return map[string]string{
-		"ID":     "ID",
-		"Name":   "Name",
-		"Size":   "Size",
-		"Count":  "Count",
-		"Tags":   "Tags",
-		"Labels": "Labels",
-		"Taints": "Taints",
-		"Nodes":  "Nodes",
+		"ID":         "ID",
+		"Name":       "Name",
+		"Size":       "Size",
+		"Count":      "Count",
+		"AutoScale":  "Auto Scale",
+		"Tags":       "Tags",
+		"Labels":     "Labels",
+		"Taints":     "Taints",
+		"Nodes":      "Nodes",
+		"NodeStatus": "Node Status",
 	}
 }
 
@@ -191,19 +242,32 @@ func (nodePools *KubernetesNodePools) KV() []map[string]any {
 	for _, nodePools := range nodePools.KubernetesNodePools {
 		tags := strings.Join(nodePools.Tags, ",")
 		nodes := make([]string, 0, len(nodePools.Nodes))
+		statuses := make([]string, 0, len(nodePools.Nodes))
 		for _, node := range nodePools.Nodes {
 			nodes = append(nodes, node.Name)
+			state := "unknown"
+			if node.Status != nil && node.Status.State != "" {
+				state = node.Status.State
+			}
+			statuses = append(statuses, node.Name+":"+state)
+		}
+
+		autoScale := "false"
+		if nodePools.AutoScale {
+			autoScale = fmt.Sprintf("%d-%d", nodePools.MinNodes, nodePools.MaxNodes)
 		}
 
 		o := map[string]any{
-			"ID":     nodePools.ID,
-			"Name":   nodePools.Name,
-			"Size":   nodePools.Size,
-			"Count":  nodePools.Count,
-			"Tags":   tags,
-			"Labels": nodePools.Labels,
-			"Taints": nodePools.Taints,
-			"Nodes":  nodes,
+			"ID":         nodePools.ID,
+			"Name":       nodePools.Name,
+			"Size":       nodePools.Size,
+			"Count":      nodePools.Count,
+			"AutoScale":  autoScale,
+			"Tags":       tags,
+			"Labels":     nodePools.Labels,
+			"Taints":     nodePools.Taints,
+			"Nodes":      nodes,
+			"NodeStatus": statuses,
 		}
 		out = append(out, o)
 	}
@@ -331,6 +395,38 @@ func (nodeSizes *KubernetesNodeSizes) KV() []map[string]any {
 	return out
 }
 
+type KubernetesClusterEvents struct {
+	Events []*godo.KubernetesClusterStatusMessage
+}
+
+var _ Displayable = &KubernetesClusterEvents{}
+
+func (e *KubernetesClusterEvents) JSON(out io.Writer) error {
+	return writeJSON(e.Events, out)
+}
+
+func (e *KubernetesClusterEvents) Cols() []string {
+	return []string{"Timestamp", "Message"}
+}
+
+func (e *KubernetesClusterEvents) ColMap() map[string]string {
+	return map[string]string{
+		"Timestamp": "Timestamp",
+		"Message":   "Message",
+	}
+}
+
+func (e *KubernetesClusterEvents) KV() []map[string]any {
+	out := make([]map[string]any, 0, len(e.Events))
+	for _, ev := range e.Events {
+		out = append(out, map[string]any{
+			"Timestamp": ev.Timestamp,
+			"Message":   ev.Message,
+		})
+	}
+	return out
+}
+
 type KubernetesAssociatedResources struct {
 	KubernetesAssociatedResources *do.KubernetesAssociatedResources
 }
@@ -17,13 +17,18 @@ import (
 	"fmt"
 	"io"
 	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/digitalocean/doctl/do"
+	"github.com/digitalocean/godo"
 )
 
 type LoadBalancer struct {
 	LoadBalancers do.LoadBalancers
+	// HealthStatus maps load balancer IDs to a summary of their health. When
+	// set, a HealthStatus column is added to the output.
+	HealthStatus map[string]string
 }
 
 var _ Displayable = &LoadBalancer{}
@@ -33,7 +38,7 @@ func (lb *LoadBalancer) JSON(out io.Writer) error {
 }
 
 func (lb *LoadBalancer) Cols() []string {
-	return []string{
+	cols := []string{
 		"ID",
 		"IP",
 		"IPv6",
@@ -46,6 +51,7 @@ func (lb *LoadBalancer) Cols() []string {
 		"VPCUUID",
 		"Tag",
 		"DropletIDs",
+		"BackendCount",
 		"RedirectHttpToHttps",
 		"StickySessions",
 		"HealthCheck",
@@ -53,6 +59,10 @@ func (lb *LoadBalancer) Cols() []string {
 		"Firewall",
 		"DisableLetsEncryptDNSRecords",
 	}
+	if lb.HealthStatus != nil {
+		cols = append(cols, "HealthStatus")
+	}
+	return cols
 }
 
 func (lb *LoadBalancer) ColMap() map[string]string {
@@ -69,12 +79,14 @@ func (lb *LoadBalancer) ColMap() map[string]string {
 		"VPCUUID":                      "VPC UUID",
 		"Tag":                          "Tag",
 		"DropletIDs":                   "Droplet IDs",
+		"BackendCount":                 "Backend Count",
 		"RedirectHttpToHttps":          "SSL",
 		"StickySessions":               "Sticky Sessions",
 		"HealthCheck":                  "Health Check",
 		"ForwardingRules":              "Forwarding Rules",
 		"Firewall":                     "Firewall Rules",
 		"DisableLetsEncryptDNSRecords": "Disable Lets Encrypt DNS Records",
+		"HealthStatus":                 "Health Status",
 	}
 }
 
@@ -102,6 +114,7 @@ func (lb *LoadBalancer) KV() []map[string]any {
 			"HealthCheck":                  prettyPrintStruct(l.HealthCheck),
 			"ForwardingRules":              strings.Join(forwardingRules, " "),
 			"DisableLetsEncryptDNSRecords": toBool(l.DisableLetsEncryptDNSRecords),
+			"BackendCount":                 loadBalancerBackendCount(l),
 		}
 		if l.Region != nil {
 			o["Region"] = l.Region.Slug
@@ -115,12 +128,180 @@ func (lb *LoadBalancer) KV() []map[string]any {
 		if l.Firewall != nil {
 			o["Firewall"] = prettyPrintStruct(l.Firewall)
 		}
+		if lb.HealthStatus != nil {
+			o["HealthStatus"] = lb.HealthStatus[l.ID]
+		}
 		out = append(out, o)
 	}
 
 	return out
 }
 
+// LoadBalancerAlgorithmInfo describes a load balancing algorithm.
+type LoadBalancerAlgorithmInfo struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type LoadBalancerAlgorithm struct {
+	Algorithms []LoadBalancerAlgorithmInfo
+}
+
+var _ Displayable = &LoadBalancerAlgorithm{}
+
+func (a *LoadBalancerAlgorithm) JSON(out io.Writer) error {
+	return writeJSON(a.Algorithms, out)
+}
+
+func (a *LoadBalancerAlgorithm) Cols() []string {
+	return []string{"Name", "Description"}
+}
+
+func (a *LoadBalancerAlgorithm) ColMap() map[string]string {
+	return map[string]string{
+		"Name":        "Name",
+		"Description": "Description",
+	}
+}
+
+func (a *LoadBalancerAlgorithm) KV() []map[string]any {
+	out := make([]map[string]any, 0, len(a.Algorithms))
+	for _, algorithm := range a.Algorithms {
+		out = append(out, map[string]any{
+			"Name":        algorithm.Name,
+			"Description": algorithm.Description,
+		})
+	}
+	return out
+}
+
+type ForwardingRule struct {
+	ForwardingRules []godo.ForwardingRule
+}
+
+var _ Displayable = &ForwardingRule{}
+
+func (f *ForwardingRule) JSON(out io.Writer) error {
+	return writeJSON(f.ForwardingRules, out)
+}
+
+func (f *ForwardingRule) Cols() []string {
+	return []string{"EntryProtocol", "EntryPort", "TargetProtocol", "TargetPort", "CertificateID", "TLSPassthrough"}
+}
+
+func (f *ForwardingRule) ColMap() map[string]string {
+	return map[string]string{
+		"EntryProtocol":  "Entry Protocol",
+		"EntryPort":      "Entry Port",
+		"TargetProtocol": "Target Protocol",
+		"TargetPort":     "Target Port",
+		"CertificateID":  "Certificate ID",
+		"TLSPassthrough": "TLS Passthrough",
+	}
+}
+
+func (f *ForwardingRule) KV() []map[string]any {
+	out := make([]map[string]any, 0, len(f.ForwardingRules))
+	for _, r := range f.ForwardingRules {
+		out = append(out, map[string]any{
+			"EntryProtocol":  r.EntryProtocol,
+			"EntryPort":      r.EntryPort,
+			"TargetProtocol": r.TargetProtocol,
+			"TargetPort":     r.TargetPort,
+			"CertificateID":  r.CertificateID,
+			"TLSPassthrough": r.TlsPassthrough,
+		})
+	}
+	return out
+}
+
+type HealthCheck struct {
+	HealthCheck *godo.HealthCheck
+}
+
+var _ Displayable = &HealthCheck{}
+
+func (h *HealthCheck) JSON(out io.Writer) error {
+	return writeJSON(h.HealthCheck, out)
+}
+
+func (h *HealthCheck) Cols() []string {
+	return []string{"Protocol", "Port", "Path", "CheckIntervalSeconds", "ResponseTimeoutSeconds", "HealthyThreshold", "UnhealthyThreshold", "ProxyProtocol"}
+}
+
+func (h *HealthCheck) ColMap() map[string]string {
+	return map[string]string{
+		"Protocol":               "Protocol",
+		"Port":                   "Port",
+		"Path":                   "Path",
+		"CheckIntervalSeconds":   "Check Interval Seconds",
+		"ResponseTimeoutSeconds": "Response Timeout Seconds",
+		"HealthyThreshold":       "Healthy Threshold",
+		"UnhealthyThreshold":     "Unhealthy Threshold",
+		"ProxyProtocol":          "Proxy Protocol",
+	}
+}
+
+func (h *HealthCheck) KV() []map[string]any {
+	if h.HealthCheck == nil {
+		return nil
+	}
+	hc := h.HealthCheck
+	return []map[string]any{{
+		"Protocol":               hc.Protocol,
+		"Port":                   hc.Port,
+		"Path":                   hc.Path,
+		"CheckIntervalSeconds":   hc.CheckIntervalSeconds,
+		"ResponseTimeoutSeconds": hc.ResponseTimeoutSeconds,
+		"HealthyThreshold":       hc.HealthyThreshold,
+		"UnhealthyThreshold":     hc.UnhealthyThreshold,
+		"ProxyProtocol":          toBool(hc.ProxyProtocol),
+	}}
+}
+
+type StickySessions struct {
+	StickySessions *godo.StickySessions
+}
+
+var _ Displayable = &StickySessions{}
+
+func (s *StickySessions) JSON(out io.Writer) error {
+	return writeJSON(s.StickySessions, out)
+}
+
+func (s *StickySessions) Cols() []string {
+	return []string{"Type", "CookieName", "CookieTTLSeconds"}
+}
+
+func (s *StickySessions) ColMap() map[string]string {
+	return map[string]string{
+		"Type":             "Type",
+		"CookieName":       "Cookie Name",
+		"CookieTTLSeconds": "Cookie TTL Seconds",
+	}
+}
+
+func (s *StickySessions) KV() []map[string]any {
+	ss := s.StickySessions
+	if ss == nil {
+		ss = &godo.StickySessions{Type: "none"}
+	}
+	return []map[string]any{{
+		"Type":             ss.Type,
+		"CookieName":       ss.CookieName,
+		"CookieTTLSeconds": ss.CookieTtlSeconds,
+	}}
+}
+
+// loadBalancerBackendCount returns the number of Droplets behind a load
+// balancer, or the tag used to select them when they are assigned by tag.
+func loadBalancerBackendCount(l do.LoadBalancer) string {
+	if l.Tag != "" {
+		return "tag: " + l.Tag
+	}
+	return strconv.Itoa(len(l.DropletIDs))
+}
+
 func toBool(b *bool) bool {
 	if b == nil {
 		return false
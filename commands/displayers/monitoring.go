@@ -14,8 +14,10 @@ limitations under the License.
 package displayers
 
 import (
+	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	"github.com/digitalocean/doctl/do"
 )
@@ -85,3 +87,50 @@ func (a *AlertPolicy) KV() []map[string]any {
 
 	return out
 }
+
+// MetricPoint holds the value of each metric series at a single timestamp.
+type MetricPoint struct {
+	Time   time.Time          `json:"time"`
+	Values map[string]float64 `json:"values"`
+}
+
+// Metrics displays one or more metric series as a time-ordered table with a
+// column per series.
+type Metrics struct {
+	Series []string
+	Points []MetricPoint
+}
+
+var _ Displayable = &Metrics{}
+
+func (m *Metrics) JSON(out io.Writer) error {
+	return writeJSON(m.Points, out)
+}
+
+func (m *Metrics) Cols() []string {
+	return append([]string{"Time"}, m.Series...)
+}
+
+func (m *Metrics) ColMap() map[string]string {
+	cm := map[string]string{"Time": "Time"}
+	for _, s := range m.Series {
+		cm[s] = s
+	}
+	return cm
+}
+
+func (m *Metrics) KV() []map[string]any {
+	out := make([]map[string]any, 0, len(m.Points))
+	for _, p := range m.Points {
+		o := map[string]any{"Time": p.Time.UTC().Format(time.RFC3339)}
+		for _, s := range m.Series {
+			o[s] = ""
+			if v, ok := p.Values[s]; ok {
+				o[s] = fmt.Sprintf("%.2f", v)
+			}
+		}
+		out = append(out, o)
+	}
+
+	return out
+}
@@ -21,6 +21,8 @@ import (
 	"reflect"
 	"strings"
 	"text/tabwriter"
+
+	"github.com/fatih/color"
 )
 
 // Displayable is a displayable entity. These are used for printing results.
@@ -31,6 +33,15 @@ type Displayable interface {
 	JSON(io.Writer) error
 }
 
+// Highlighter is implemented by displayables that color some rows of their
+// text output, for example to flag resources that need attention. Colors are
+// only written when stdout is a terminal.
+type Highlighter interface {
+	// Highlight returns the color of the KV row at index i, or nil to leave
+	// the row uncolored.
+	Highlight(i int) *color.Color
+}
+
 // Displayer has the display options, the item to display, and where to display to
 type Displayer struct {
 	OutputType string
@@ -67,12 +78,24 @@ func (d *Displayer) Display() error {
 // DisplayText writes tabbed content to the passed in io.Writer
 // while potentially adding or removing headers.
 func DisplayText(item Displayable, out io.Writer, noHeaders bool, includeCols []string) error {
+	// Highlighted rows are colored after alignment, since the tab writer
+	// would count the escape codes towards the column widths.
+	highlighter, highlight := item.(Highlighter)
+	dest := out
+	var aligned bytes.Buffer
+	if highlight {
+		out = &aligned
+	}
+
 	w := new(tabwriter.Writer)
 	w.Init(out, 0, 0, 4, ' ', 0)
 
 	cols := item.Cols()
 	if len(includeCols) > 0 && includeCols[0] != "" {
-		cols = includeCols
+		cols = make([]string, 0, len(includeCols))
+		for _, c := range includeCols {
+			cols = append(cols, canonicalColumn(item.ColMap(), c))
+		}
 	}
 
 	if !noHeaders {
@@ -114,7 +137,46 @@ func DisplayText(item Displayable, out io.Writer, noHeaders bool, includeCols []
 		fmt.Fprintf(w, format+"\n", values...)
 	}
 
-	return w.Flush()
+	if err := w.Flush(); err != nil || !highlight {
+		return err
+	}
+
+	row := 0
+	if !noHeaders {
+		row = -1
+	}
+	for _, line := range strings.SplitAfter(aligned.String(), "\n") {
+		if line == "" {
+			continue
+		}
+		if row >= 0 {
+			if c := highlighter.Highlight(row); c != nil {
+				line = c.Sprint(strings.TrimSuffix(line, "\n")) + "\n"
+			}
+		}
+		row++
+
+		if _, err := io.WriteString(dest, line); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// canonicalColumn returns the column in colMap matching col, ignoring case, so
+// that for example `--format id` selects the ID column. Unknown columns are
+// returned unchanged.
+func canonicalColumn(colMap map[string]string, col string) string {
+	if _, ok := colMap[col]; ok {
+		return col
+	}
+	for k := range colMap {
+		if strings.EqualFold(k, col) {
+			return k
+		}
+	}
+	return col
 }
 
 func writeJSON(item any, w io.Writer) error {
@@ -3,8 +3,11 @@ package displayers
 import (
 	"bytes"
 	"testing"
+	"time"
 
 	"github.com/digitalocean/doctl/do"
+	"github.com/digitalocean/godo"
+	"github.com/fatih/color"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -28,6 +31,11 @@ func TestDisplayerDisplay(t *testing.T) {
 			item:         &Volume{Volumes: nilVolumes},
 			expectedJSON: `[]`,
 		},
+		{
+			name:         "displaying a nil slice of Volumes with resolved Droplet names should return an empty JSON array",
+			item:         &Volume{Volumes: nilVolumes, DropletNames: map[int]string{}},
+			expectedJSON: `[]`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -46,3 +54,29 @@ func TestDisplayerDisplay(t *testing.T) {
 		})
 	}
 }
+
+func TestDisplayTextHighlight(t *testing.T) {
+	noColor := color.NoColor
+	color.NoColor = false
+	defer func() { color.NoColor = noColor }()
+
+	item := &Certificate{Certificates: do.Certificates{
+		{Certificate: &godo.Certificate{Name: "expired", NotAfter: "2017-02-22T00:23:00Z"}},
+		{Certificate: &godo.Certificate{Name: "expiring", NotAfter: time.Now().Add(24 * time.Hour).Format(time.RFC3339)}},
+		{Certificate: &godo.Certificate{Name: "valid", NotAfter: time.Now().Add(90 * 24 * time.Hour).Format(time.RFC3339)}},
+	}}
+
+	out := &bytes.Buffer{}
+	err := DisplayText(item, out, false, []string{"Name"})
+	assert.NoError(t, err)
+	assert.Equal(t, "Name\n\x1b[31mexpired\x1b[0m\n\x1b[33mexpiring\x1b[0m\nvalid\n", out.String())
+}
+
+func TestDisplayTextColumnCase(t *testing.T) {
+	item := &Certificate{Certificates: do.Certificates{{Certificate: &godo.Certificate{Name: "web"}}}}
+
+	out := &bytes.Buffer{}
+	err := DisplayText(item, out, true, []string{"name"})
+	assert.NoError(t, err)
+	assert.Equal(t, "web\n", out.String())
+}
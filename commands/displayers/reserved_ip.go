@@ -39,6 +39,7 @@ func (rip *ReservedIP) Cols() []string {
 func (rip *ReservedIP) ColMap() map[string]string {
 	return map[string]string{
 		"IP": "IP", "Region": "Region", "DropletID": "Droplet ID", "DropletName": "Droplet Name", "ProjectID": "Project ID",
+		"AssignedDropletName": "Assigned Droplet Name",
 	}
 }
 
@@ -55,7 +56,7 @@ func (rip *ReservedIP) KV() []map[string]any {
 		o := map[string]any{
 			"IP": f.IP, "Region": f.Region.Slug,
 			"DropletID": dropletID, "DropletName": dropletName,
-			"ProjectID": f.ProjectID,
+			"AssignedDropletName": dropletName, "ProjectID": f.ProjectID,
 		}
 
 		out = append(out, o)
@@ -24,19 +24,31 @@ import (
 
 type Volume struct {
 	Volumes []do.Volume
+	// DropletNames maps Droplet IDs to Droplet names. When set, the
+	// AttachedTo column is displayed.
+	DropletNames map[int]string
 }
 
 var _ Displayable = &Volume{}
 
 func (a *Volume) JSON(out io.Writer) error {
+	// The Displayer only turns nil slices into an empty array for
+	// single-field displayables, so handle that here too.
+	if a.Volumes == nil {
+		return writeJSON([]do.Volume{}, out)
+	}
 	return writeJSON(a.Volumes, out)
 
 }
 
 func (a *Volume) Cols() []string {
-	return []string{
+	cols := []string{
 		"ID", "Name", "Size", "Region", "Filesystem Type", "Filesystem Label", "DropletIDs", "Tags",
 	}
+	if a.DropletNames != nil {
+		cols = append(cols, "AttachedTo")
+	}
+	return cols
 }
 
 func (a *Volume) ColMap() map[string]string {
@@ -49,6 +61,7 @@ func (a *Volume) ColMap() map[string]string {
 		"Filesystem Label": "Filesystem Label",
 		"DropletIDs":       "Droplet IDs",
 		"Tags":             "Tags",
+		"AttachedTo":       "Attached To",
 	}
 
 }
@@ -72,6 +85,11 @@ func (a *Volume) KV() []map[string]any {
 		if len(volume.DropletIDs) != 0 {
 			m["DropletIDs"] = fmt.Sprintf("%v", volume.DropletIDs)
 		}
+		attached := make([]string, 0, len(volume.DropletIDs))
+		for _, id := range volume.DropletIDs {
+			attached = append(attached, fmt.Sprintf("%s(%d)", a.DropletNames[id], id))
+		}
+		m["AttachedTo"] = strings.Join(attached, ",")
 		out = append(out, m)
 
 	}
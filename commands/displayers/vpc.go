@@ -15,6 +15,7 @@ package displayers
 
 import (
 	"io"
+	"strings"
 
 	"github.com/digitalocean/doctl/do"
 )
@@ -52,6 +53,7 @@ func (v *VPC) ColMap() map[string]string {
 		"Region":      "Region",
 		"Created":     "Created At",
 		"Default":     "Default",
+		"IsDefault":   "Is Default",
 	}
 }
 
@@ -68,6 +70,62 @@ func (v *VPC) KV() []map[string]any {
 			"Created":     v.CreatedAt,
 			"Region":      v.RegionSlug,
 			"Default":     v.Default,
+			"IsDefault":   v.Default,
+		}
+		out = append(out, o)
+	}
+
+	return out
+}
+
+type VPCMember struct {
+	VPCMembers do.VPCMembers
+	// Region is the region of the VPC, which all of its members share.
+	Region string
+}
+
+var _ Displayable = &VPCMember{}
+
+func (v *VPCMember) JSON(out io.Writer) error {
+	return writeJSON(v.VPCMembers, out)
+}
+
+func (v *VPCMember) Cols() []string {
+	return []string{
+		"URN",
+		"Type",
+		"Name",
+		"Region",
+		"Created",
+	}
+}
+
+func (v *VPCMember) ColMap() map[string]string {
+	return map[string]string{
+		"URN":     "URN",
+		"Type":    "Type",
+		"Name":    "Name",
+		"Region":  "Region",
+		"Created": "Created At",
+	}
+}
+
+func (v *VPCMember) KV() []map[string]any {
+	out := make([]map[string]any, 0, len(v.VPCMembers))
+
+	for _, m := range v.VPCMembers {
+		// URNs have the form do:<resource type>:<id>.
+		var resourceType string
+		if parts := strings.SplitN(m.URN, ":", 3); len(parts) == 3 {
+			resourceType = parts[1]
+		}
+
+		o := map[string]any{
+			"URN":     m.URN,
+			"Type":    resourceType,
+			"Name":    m.Name,
+			"Region":  v.Region,
+			"Created": m.CreatedAt,
 		}
 		out = append(out, o)
 	}
@@ -14,9 +14,15 @@ limitations under the License.
 package commands
 
 import (
+	"encoding/csv"
 	"errors"
 	"fmt"
+	"io"
+	"os"
+	"slices"
 	"strconv"
+	"strings"
+	"text/tabwriter"
 
 	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/commands/displayers"
@@ -38,11 +44,16 @@ func Domain() *Command {
 	cmdDomainCreate := CmdBuilder(cmd, RunDomainCreate, "create <domain>", "Add a domain to your account", `Adds a domain to your account that you can assign to Droplets, load balancers, and other resources.`, Writer,
 		aliasOpt("c"), displayerType(&displayers.Domain{}))
 	AddStringFlag(cmdDomainCreate, doctl.ArgIPAddress, "", "", "Creates an A record for a IPv4 address")
+	addTerraformImportFlag(cmdDomainCreate)
 	cmdDomainCreate.Example = `The following command creates a domain named example.com and adds an A record to the domain: doctl compute domain create example.com --ip-address 198.51.100.215`
 
 	cmdDomainList := CmdBuilder(cmd, RunDomainList, "list", "List all domains on your account", `Retrieves a list of domains on your account.`, Writer,
 		aliasOpt("ls"), displayerType(&displayers.Domain{}))
-	cmdDomainList.Example = `The following command lists all domains on your account: doctl compute domain list`
+	AddBoolFlag(cmdDomainList, doctl.ArgDomainWithDetails, "", false, "Fetches the records of each domain to display the `RecordCount` and `NSServers` columns. This makes one additional API request per domain.")
+	AddDurationFlag(cmdDomainList, doctl.ArgExpiringWithin, "", 0, "Not currently supported. DigitalOcean hosts DNS for domains but is not a registrar, and the API does not report registration expiry dates. Passing this flag returns an error; check expiry dates with your domain registrar instead.")
+	cmdDomainList.Example = `The following command lists all domains on your account: doctl compute domain list
+
+The following command lists all domains along with their record count and name servers: doctl compute domain list --with-details`
 
 	cmdDomainGet := CmdBuilder(cmd, RunDomainGet, "get <domain>", "Retrieve information about a domain", `Retrieves information about a domain on your account.`, Writer,
 		aliasOpt("g"), displayerType(&displayers.Domain{}))
@@ -61,9 +72,16 @@ func Domain() *Command {
 	}
 	cmd.AddCommand(cmdRecord)
 
-	cmdRecordList := CmdBuilder(cmdRecord, RunRecordList, "list <domain>", "List the DNS records for a domain", `Lists the DNS records for a domain.`, Writer,
+	cmdRecordList := CmdBuilder(cmdRecord, RunRecordList, "list <domain>", "List the DNS records for a domain", `Lists the DNS records for a domain.
+
+In addition to the `+"`"+`text`+"`"+` and `+"`"+`json`+"`"+` output formats, this command supports `+"`"+`--output bind`+"`"+`, which prints the records in BIND zone file syntax: `+"`"+`NAME TTL CLASS TYPE RDATA`+"`"+`.`, Writer,
 		aliasOpt("ls"), displayerType(&displayers.DomainRecord{}))
-	cmdRecordList.Example = `The following command lists the DNS records for the domain example.com. The command also uses the ` + "`" + `--format` + "`" + ` flag to only return each record's ID, type, and TTL: doctl compute domain records list example.com --format ID,Type,TTL`
+	AddStringFlag(cmdRecordList, doctl.ArgRecordDataContains, "", "", "Lists only the records whose data contains the given text, such as an IP address or a host name. The match is case-insensitive.")
+	cmdRecordList.Example = `The following command lists the DNS records for the domain example.com. The command also uses the ` + "`" + `--format` + "`" + ` flag to only return each record's ID, type, and TTL: doctl compute domain records list example.com --format ID,Type,TTL
+
+The following command lists the DNS records for the domain example.com that point to the IP address 203.0.113.10: doctl compute domain records list example.com --data-contains 203.0.113.10
+
+The following command prints the DNS records for the domain example.com in zone file syntax: doctl compute domain records list example.com --output bind`
 
 	cmdRecordCreate := CmdBuilder(cmdRecord, RunRecordCreate, "create <domain>", "Create a DNS record", `Create DNS records for a domain.`, Writer,
 		aliasOpt("c"), displayerType(&displayers.DomainRecord{}))
@@ -76,13 +94,42 @@ func Domain() *Command {
 	AddIntFlag(cmdRecordCreate, doctl.ArgRecordWeight, "", 0, "The weight value for an SRV record")
 	AddIntFlag(cmdRecordCreate, doctl.ArgRecordFlags, "", 0, "The flag value of a CAA record. A valid is an unsigned integer between 0-255.")
 	AddStringFlag(cmdRecordCreate, doctl.ArgRecordTag, "", "", "The parameter tag for CAA records. Valid values are `issue`, `issuewild`, or `iodef`")
+	AddIntFlag(cmdRecordCreate, doctl.ArgRecordMXPriority, "", 0, "The priority of an MX record. Used in place of `--record-priority`.")
+	AddStringFlag(cmdRecordCreate, doctl.ArgRecordMXExchange, "", "", "The mail server host name of an MX record. Used in place of `--record-data`.")
+	AddIntFlag(cmdRecordCreate, doctl.ArgRecordSRVPriority, "", 0, "The priority of an SRV record. Used in place of `--record-priority`.")
+	AddIntFlag(cmdRecordCreate, doctl.ArgRecordSRVWeight, "", 0, "The weight of an SRV record. Used in place of `--record-weight`.")
+	AddIntFlag(cmdRecordCreate, doctl.ArgRecordSRVPort, "", 0, "The port of an SRV record. Used in place of `--record-port`.")
+	AddStringFlag(cmdRecordCreate, doctl.ArgRecordSRVTarget, "", "", "The target host name of an SRV record. Used in place of `--record-data`.")
 
-	cmdRecordCreate.Example = `The following command creates an A record for the domain example.com: doctl compute domain records create example.com --record-type A --record-name example.com --record-data 198.51.100.215`
+	cmdRecordCreate.Example = `The following command creates an A record for the domain example.com: doctl compute domain records create example.com --record-type A --record-name example.com --record-data 198.51.100.215
 
-	cmdRunRecordDelete := CmdBuilder(cmdRecord, RunRecordDelete, "delete <domain> <record-id>...", "Delete a DNS record", `Deletes DNS records for a domain.`, Writer,
+The following command creates an MX record for the domain example.com: doctl compute domain records create example.com --record-type MX --record-name @ --mx-priority 10 --mx-exchange mail.example.com.`
+
+	cmdRunRecordDelete := CmdBuilder(cmdRecord, RunRecordDelete, "delete <domain> <record-id>...", "Delete a DNS record", `Deletes DNS records for a domain.
+
+Use the `+"`"+`--all`+"`"+` flag instead of record IDs to delete every record of the domain matching the `+"`"+`--record-type`+"`"+` filter. When `+"`"+`--all`+"`"+` is used without `+"`"+`--record-type`+"`"+`, you must confirm the deletion by typing the domain name, even if `+"`"+`--force`+"`"+` is set.`, Writer,
 		aliasOpt("d", "rm"))
 	AddBoolFlag(cmdRunRecordDelete, doctl.ArgForce, doctl.ArgShortForce, false, "Delete record without confirmation prompt")
-	cmdRunRecordDelete.Example = `The following command deletes a DNS record with the ID ` + "`" + `98858421` + "`" + ` from the domain ` + "`" + `example.com` + "`" + `: doctl compute domain records delete example.com 98858421`
+	AddBoolFlag(cmdRunRecordDelete, doctl.ArgRecordDeleteAll, "", false, "Delete all records of the domain matching the `--record-type` filter. Without a filter, the SOA record and the apex NS records are kept")
+	AddStringFlag(cmdRunRecordDelete, doctl.ArgRecordType, "", "", "The type of DNS records to delete when used with `--all`")
+	cmdRunRecordDelete.Example = `The following command deletes a DNS record with the ID ` + "`" + `98858421` + "`" + ` from the domain ` + "`" + `example.com` + "`" + `: doctl compute domain records delete example.com 98858421
+
+The following command deletes all TXT records from the domain ` + "`" + `example.com` + "`" + `: doctl compute domain records delete example.com --all --record-type TXT`
+
+	cmdRecordImportCSV := CmdBuilder(cmdRecord, RunRecordImportCSV, "import-csv <domain>", "Import DNS records from a CSV file", `Creates DNS records for a domain from a CSV file. Each row describes one record with the columns `+"`"+`type,name,data,ttl,priority`+"`"+`. The `+"`"+`ttl`+"`"+` and `+"`"+`priority`+"`"+` columns may be left empty, in which case they default to 1800 and 0. A header row starting with `+"`"+`type`+"`"+` is skipped. Supported record types are `+"`"+`A`+"`"+`, `+"`"+`AAAA`+"`"+`, `+"`"+`CNAME`+"`"+`, `+"`"+`MX`+"`"+`, `+"`"+`NS`+"`"+`, and `+"`"+`TXT`+"`"+`; use `+"`"+`doctl compute domain records create`+"`"+` for CAA and SRV records.
+
+Every row is validated before any record is created. After the import, the result of each row is printed and the command fails if any record could not be created.`, Writer)
+	AddStringFlag(cmdRecordImportCSV, doctl.ArgRecordImportFile, "", "", "The path to the CSV file to import. Use `-` to read from standard input.", requiredOpt())
+	AddBoolFlag(cmdRecordImportCSV, doctl.ArgRecordImportOverwrite, "", false, "Deletes existing records with the same type and name as an imported record before creating it")
+	AddBoolFlag(cmdRecordImportCSV, doctl.ArgDryRun, "", false, "Prints the records that would be created and deleted without changing anything")
+	cmdRecordImportCSV.Example = `The following command creates the records listed in ` + "`" + `records.csv` + "`" + ` for the domain example.com, replacing any existing records with the same type and name: doctl compute domain records import-csv example.com --file records.csv --overwrite
+
+An example ` + "`" + `records.csv` + "`" + ` file:
+
+    type,name,data,ttl,priority
+    A,@,198.51.100.215,3600,
+    CNAME,www,@,,
+    MX,@,mail.example.com.,,10`
 
 	cmdRecordUpdate := CmdBuilder(cmdRecord, RunRecordUpdate, "update <domain>", "Update a DNS record", `Updates or changes the properties of DNS records for a domain.`, Writer,
 		aliasOpt("u"), displayerType(&displayers.DomainRecord{}))
@@ -127,11 +174,26 @@ func RunDomainCreate(c *CmdConfig) error {
 		return err
 	}
 
+	tfImport, err := c.Doit.GetBool(c.NS, doctl.ArgTerraformImport)
+	if err != nil {
+		return err
+	}
+	if tfImport {
+		printTerraformImport("digitalocean_domain", d.Name, d.Name)
+	}
+
 	return c.Display(&displayers.Domain{Domains: do.Domains{*d}})
 }
 
 // RunDomainList runs domain create.
 func RunDomainList(c *CmdConfig) error {
+	expiringWithin, err := c.Doit.GetDuration(c.NS, doctl.ArgExpiringWithin)
+	if err != nil {
+		return err
+	}
+	if expiringWithin > 0 {
+		return fmt.Errorf("the --%s flag is not supported for domains: the DigitalOcean API does not report domain registration expiry dates", doctl.ArgExpiringWithin)
+	}
 
 	ds := c.Domains()
 
@@ -140,7 +202,23 @@ func RunDomainList(c *CmdConfig) error {
 		return err
 	}
 
+	withDetails, err := c.Doit.GetBool(c.NS, doctl.ArgDomainWithDetails)
+	if err != nil {
+		return err
+	}
+
 	item := &displayers.Domain{Domains: domains}
+	if withDetails {
+		item.Records = make(map[string]do.DomainRecords, len(domains))
+		for _, d := range domains {
+			records, err := ds.Records(d.Name)
+			if err != nil {
+				return err
+			}
+			item.Records[d.Name] = records
+		}
+	}
+
 	return c.Display(item)
 }
 
@@ -208,14 +286,79 @@ func RunRecordList(c *CmdConfig) error {
 		return errors.New("Domain name is missing.")
 	}
 
+	dataContains, err := c.Doit.GetString(c.NS, doctl.ArgRecordDataContains)
+	if err != nil {
+		return err
+	}
+
 	list, err := ds.Records(name)
 	if err != nil {
 		return err
 	}
 
+	if dataContains != "" {
+		filtered := make(do.DomainRecords, 0, len(list))
+		for _, r := range list {
+			if strings.Contains(strings.ToLower(r.Data), strings.ToLower(dataContains)) {
+				filtered = append(filtered, r)
+			}
+		}
+		list = filtered
+	}
+
+	if Output == "bind" {
+		return writeBINDRecords(c.Out, name, list)
+	}
+
 	return displayDomainRecords(c, list...)
 }
 
+// writeBINDRecords writes domain records in BIND zone file syntax.
+func writeBINDRecords(out io.Writer, domain string, records do.DomainRecords) error {
+	origin := strings.TrimSuffix(domain, ".") + "."
+	fqdn := func(name string) string {
+		switch {
+		case name == "@" || name == "":
+			return origin
+		case strings.HasSuffix(name, "."):
+			return name
+		default:
+			return name + "." + origin
+		}
+	}
+	// Record data holds host names that are either relative to the
+	// domain or fully qualified without the trailing dot.
+	target := func(data string) string {
+		if data != "@" && strings.Contains(data, ".") && !strings.HasSuffix(data, ".") {
+			return data + "."
+		}
+		return fqdn(data)
+	}
+
+	w := new(tabwriter.Writer)
+	w.Init(out, 0, 0, 1, ' ', 0)
+	for _, r := range records {
+		var rdata string
+		switch r.Type {
+		case "CNAME", "NS":
+			rdata = target(r.Data)
+		case "MX":
+			rdata = fmt.Sprintf("%d %s", r.Priority, target(r.Data))
+		case "SRV":
+			rdata = fmt.Sprintf("%d %d %d %s", r.Priority, r.Weight, r.Port, target(r.Data))
+		case "CAA":
+			rdata = fmt.Sprintf("%d %s %q", r.Flags, r.Tag, r.Data)
+		case "TXT":
+			rdata = strconv.Quote(r.Data)
+		default:
+			rdata = r.Data
+		}
+		fmt.Fprintf(w, "%s\t%d\tIN\t%s\t%s\n", fqdn(r.Name), r.TTL, r.Type, rdata)
+	}
+
+	return w.Flush()
+}
+
 // RunRecordCreate creates a domain record.
 func RunRecordCreate(c *CmdConfig) error {
 	err := ensureOneArg(c)
@@ -287,6 +430,11 @@ func RunRecordCreate(c *CmdConfig) error {
 		return errors.New("Record request is missing type.")
 	}
 
+	err = applyRecordTypeFlags(c, drcr)
+	if err != nil {
+		return err
+	}
+
 	r, err := ds.CreateRecord(name, drcr)
 	if err != nil {
 		return err
@@ -298,6 +446,14 @@ func RunRecordCreate(c *CmdConfig) error {
 
 // RunRecordDelete deletes a domain record.
 func RunRecordDelete(c *CmdConfig) error {
+	all, err := c.Doit.GetBool(c.NS, doctl.ArgRecordDeleteAll)
+	if err != nil {
+		return err
+	}
+	if all {
+		return runRecordDeleteAll(c)
+	}
+
 	if len(c.Args) < 2 {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
@@ -334,6 +490,255 @@ func RunRecordDelete(c *CmdConfig) error {
 
 }
 
+// runRecordDeleteAll deletes all records of a domain matching the record
+// type filter. Without a filter, the SOA record and the apex NS records are
+// kept, as the API refuses to delete them. A failed deletion doesn't stop the
+// remaining ones; the failures are reported together.
+func runRecordDeleteAll(c *CmdConfig) error {
+	if len(c.Args) != 1 {
+		return fmt.Errorf("the --%s flag accepts a domain name only, not record IDs", doctl.ArgRecordDeleteAll)
+	}
+	domainName := c.Args[0]
+
+	force, err := c.Doit.GetBool(c.NS, doctl.ArgForce)
+	if err != nil {
+		return err
+	}
+
+	recordType, err := c.Doit.GetString(c.NS, doctl.ArgRecordType)
+	if err != nil {
+		return err
+	}
+
+	ds := c.Domains()
+	records, err := ds.Records(domainName)
+	if err != nil {
+		return err
+	}
+
+	var matches do.DomainRecords
+	for _, r := range records {
+		if recordType == "" {
+			if r.Type == "SOA" || (r.Type == "NS" && r.Name == "@") {
+				continue
+			}
+			matches = append(matches, r)
+		} else if strings.EqualFold(r.Type, recordType) {
+			matches = append(matches, r)
+		}
+	}
+	if len(matches) == 0 {
+		notice("No matching records found for %s", domainName)
+		return nil
+	}
+
+	if recordType == "" {
+		err = AskForConfirmTyped(fmt.Sprintf("delete all %d records of %s", len(matches), domainName), domainName)
+	} else if !force {
+		err = AskForConfirmDelete(recordType+" record", len(matches))
+	}
+	if err != nil {
+		return errOperationAborted
+	}
+
+	var failed []string
+	for _, r := range matches {
+		if err := ds.DeleteRecord(domainName, r.ID); err != nil {
+			failed = append(failed, fmt.Sprintf("record %d: %v", r.ID, err))
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to delete %d of %d records of %s:\n%s", len(failed), len(matches), domainName, strings.Join(failed, "\n"))
+	}
+
+	return nil
+}
+
+// csvRecordTypes are the record types accepted by RunRecordImportCSV. CAA
+// and SRV records need fields that the CSV format has no columns for.
+var csvRecordTypes = []string{"A", "AAAA", "CNAME", "MX", "NS", "TXT"}
+
+// csvRecord is a DNS record read from a CSV file, along with the line it was
+// read from.
+type csvRecord struct {
+	line int
+	req  *do.DomainRecordEditRequest
+}
+
+// RunRecordImportCSV creates domain records from a CSV file.
+func RunRecordImportCSV(c *CmdConfig) error {
+	err := ensureOneArg(c)
+	if err != nil {
+		return err
+	}
+	domainName := c.Args[0]
+
+	path, err := c.Doit.GetString(c.NS, doctl.ArgRecordImportFile)
+	if err != nil {
+		return err
+	}
+
+	overwrite, err := c.Doit.GetBool(c.NS, doctl.ArgRecordImportOverwrite)
+	if err != nil {
+		return err
+	}
+
+	dryRun, err := c.Doit.GetBool(c.NS, doctl.ArgDryRun)
+	if err != nil {
+		return err
+	}
+
+	var in io.Reader = os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("failed to open records file: %w", err)
+		}
+		defer f.Close()
+		in = f
+	}
+
+	records, err := readCSVRecords(in)
+	if err != nil {
+		return err
+	}
+	if len(records) == 0 {
+		return fmt.Errorf("no records found in %s", path)
+	}
+
+	ds := c.Domains()
+	var existing do.DomainRecords
+	if overwrite {
+		if existing, err = ds.Records(domainName); err != nil {
+			return err
+		}
+	}
+
+	var failed int
+	deleted := make(map[int]bool)
+	for _, r := range records {
+		desc := fmt.Sprintf("%s %s %s", r.req.Type, r.req.Name, r.req.Data)
+
+		for _, e := range existing {
+			if deleted[e.ID] || !strings.EqualFold(e.Type, r.req.Type) || e.Name != r.req.Name {
+				continue
+			}
+			deleted[e.ID] = true
+
+			if dryRun {
+				fmt.Fprintf(c.Out, "line %d: would delete record %d (%s %s %s)\n", r.line, e.ID, e.Type, e.Name, e.Data)
+				continue
+			}
+			if err := ds.DeleteRecord(domainName, e.ID); err != nil {
+				fmt.Fprintf(c.Out, "line %d: failed to delete record %d: %v\n", r.line, e.ID, err)
+				failed++
+				continue
+			}
+			fmt.Fprintf(c.Out, "line %d: deleted record %d (%s %s %s)\n", r.line, e.ID, e.Type, e.Name, e.Data)
+		}
+
+		if dryRun {
+			fmt.Fprintf(c.Out, "line %d: would create %s\n", r.line, desc)
+			continue
+		}
+		created, err := ds.CreateRecord(domainName, r.req)
+		if err != nil {
+			fmt.Fprintf(c.Out, "line %d: failed to create %s: %v\n", r.line, desc, err)
+			failed++
+			continue
+		}
+		fmt.Fprintf(c.Out, "line %d: created record %d (%s)\n", r.line, created.ID, desc)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of the changes for %s failed", failed, domainName)
+	}
+	return nil
+}
+
+// readCSVRecords reads and validates the records of a `type,name,data,ttl,priority`
+// CSV file. All invalid rows are reported together.
+func readCSVRecords(in io.Reader) ([]csvRecord, error) {
+	reader := csv.NewReader(in)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+
+	var (
+		records []csvRecord
+		errs    []string
+	)
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read records file: %w", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		if len(records) == 0 && len(errs) == 0 && strings.EqualFold(row[0], "type") {
+			continue
+		}
+
+		req, err := parseCSVRecord(row)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("line %d: %v", line, err))
+			continue
+		}
+		records = append(records, csvRecord{line: line, req: req})
+	}
+
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("invalid records file:\n%s", strings.Join(errs, "\n"))
+	}
+	return records, nil
+}
+
+func parseCSVRecord(row []string) (*do.DomainRecordEditRequest, error) {
+	if len(row) < 3 || len(row) > 5 {
+		return nil, fmt.Errorf("expected 3 to 5 columns (type,name,data,ttl,priority) but got %d", len(row))
+	}
+	for len(row) < 5 {
+		row = append(row, "")
+	}
+
+	req := &do.DomainRecordEditRequest{
+		Type: strings.ToUpper(row[0]),
+		Name: row[1],
+		Data: row[2],
+		TTL:  1800,
+	}
+	if !slices.Contains(csvRecordTypes, req.Type) {
+		return nil, fmt.Errorf("invalid record type %q; valid types are %s", row[0], strings.Join(csvRecordTypes, ", "))
+	}
+	if req.Name == "" {
+		return nil, errors.New("missing record name")
+	}
+	if req.Data == "" {
+		return nil, errors.New("missing record data")
+	}
+
+	if row[3] != "" {
+		ttl, err := strconv.Atoi(row[3])
+		if err != nil || ttl < 30 {
+			return nil, fmt.Errorf("invalid TTL %q; must be a number of seconds no less than 30", row[3])
+		}
+		req.TTL = ttl
+	}
+
+	if row[4] != "" {
+		priority, err := strconv.Atoi(row[4])
+		if err != nil || priority < 0 || priority > 65535 {
+			return nil, fmt.Errorf("invalid priority %q; must be between 0 and 65535", row[4])
+		}
+		req.Priority = priority
+	}
+
+	return req, nil
+}
+
 // RunRecordUpdate updates a domain record.
 func RunRecordUpdate(c *CmdConfig) error {
 	err := ensureOneArg(c)
@@ -414,6 +819,90 @@ func RunRecordUpdate(c *CmdConfig) error {
 	return displayDomainRecords(c, *r)
 }
 
+// applyRecordTypeFlags maps the MX and SRV specific flags onto the generic
+// fields of a record request, rejecting them for other record types.
+func applyRecordTypeFlags(c *CmdConfig, drcr *do.DomainRecordEditRequest) error {
+	mxPriority, err := c.Doit.GetIntPtr(c.NS, doctl.ArgRecordMXPriority)
+	if err != nil {
+		return err
+	}
+
+	mxExchange, err := c.Doit.GetString(c.NS, doctl.ArgRecordMXExchange)
+	if err != nil {
+		return err
+	}
+
+	srvPriority, err := c.Doit.GetIntPtr(c.NS, doctl.ArgRecordSRVPriority)
+	if err != nil {
+		return err
+	}
+
+	srvWeight, err := c.Doit.GetIntPtr(c.NS, doctl.ArgRecordSRVWeight)
+	if err != nil {
+		return err
+	}
+
+	srvPort, err := c.Doit.GetIntPtr(c.NS, doctl.ArgRecordSRVPort)
+	if err != nil {
+		return err
+	}
+
+	srvTarget, err := c.Doit.GetString(c.NS, doctl.ArgRecordSRVTarget)
+	if err != nil {
+		return err
+	}
+
+	rType := strings.ToUpper(drcr.Type)
+	hasMX := mxPriority != nil || mxExchange != ""
+	hasSRV := srvPriority != nil || srvWeight != nil || srvPort != nil || srvTarget != ""
+
+	if hasMX && rType != "MX" {
+		return fmt.Errorf("The --%s and --%s flags can only be used with MX records.", doctl.ArgRecordMXPriority, doctl.ArgRecordMXExchange)
+	}
+	if hasSRV && rType != "SRV" {
+		return fmt.Errorf("The --%s, --%s, --%s, and --%s flags can only be used with SRV records.",
+			doctl.ArgRecordSRVPriority, doctl.ArgRecordSRVWeight, doctl.ArgRecordSRVPort, doctl.ArgRecordSRVTarget)
+	}
+
+	if mxPriority != nil {
+		if *mxPriority < 0 || *mxPriority > 65535 {
+			return fmt.Errorf("Invalid MX priority %d. Must be between 0 and 65535.", *mxPriority)
+		}
+		drcr.Priority = *mxPriority
+	}
+	if mxExchange != "" {
+		drcr.Data = mxExchange
+	}
+
+	srvValues := []struct {
+		flag string
+		val  *int
+	}{
+		{doctl.ArgRecordSRVPriority, srvPriority},
+		{doctl.ArgRecordSRVWeight, srvWeight},
+		{doctl.ArgRecordSRVPort, srvPort},
+	}
+	for _, v := range srvValues {
+		if v.val != nil && (*v.val < 0 || *v.val > 65535) {
+			return fmt.Errorf("Invalid value %d for --%s. Must be between 0 and 65535.", *v.val, v.flag)
+		}
+	}
+	if srvPriority != nil {
+		drcr.Priority = *srvPriority
+	}
+	if srvWeight != nil {
+		drcr.Weight = *srvWeight
+	}
+	if srvPort != nil {
+		drcr.Port = srvPort
+	}
+	if srvTarget != "" {
+		drcr.Data = srvTarget
+	}
+
+	return nil
+}
+
 func displayDomainRecords(c *CmdConfig, records ...do.DomainRecord) error {
 	// Check the format flag to determine if the displayer should use the short
 	// layout of the record display.The short version is used by default, but to format
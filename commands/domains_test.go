@@ -14,12 +14,18 @@ limitations under the License.
 package commands
 
 import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/do"
 	"github.com/digitalocean/godo"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var (
@@ -49,6 +55,20 @@ func TestDomainsCreate(t *testing.T) {
 	})
 }
 
+func TestDomainsCreateTerraformImport(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		dcr := &godo.DomainCreateRequest{Name: "example.com"}
+		tm.domains.EXPECT().Create(dcr).Return(&testDomain, nil)
+
+		config.Args = append(config.Args, testDomain.Name)
+		config.Doit.Set(config.NS, doctl.ArgTerraformImport, true)
+		var err error
+		stderr := captureStderr(t, func() { err = RunDomainCreate(config) })
+		assert.NoError(t, err)
+		assert.Equal(t, "terraform import digitalocean_domain.example_com example.com\n", stderr)
+	})
+}
+
 func TestDomainsList(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		tm.domains.EXPECT().List().Return(testDomainList, nil)
@@ -58,6 +78,49 @@ func TestDomainsList(t *testing.T) {
 	})
 }
 
+func TestDomainsListExpiringWithin(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Doit.Set(config.NS, doctl.ArgExpiringWithin, 720*time.Hour)
+
+		err := RunDomainList(config)
+		assert.EqualError(t, err, "the --expiring-within flag is not supported for domains: the DigitalOcean API does not report domain registration expiry dates")
+	})
+}
+
+func TestDomainsListWithDetails(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		records := do.DomainRecords{
+			{DomainRecord: &godo.DomainRecord{ID: 1, Type: "NS", Data: "ns1.digitalocean.com"}},
+			{DomainRecord: &godo.DomainRecord{ID: 2, Type: "NS", Data: "ns2.digitalocean.com"}},
+			{DomainRecord: &godo.DomainRecord{ID: 3, Type: "A", Data: "127.0.0.1"}},
+		}
+		tm.domains.EXPECT().List().Return(testDomainList, nil)
+		tm.domains.EXPECT().Records("example.com").Return(records, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Doit.Set(config.NS, doctl.ArgDomainWithDetails, true)
+		config.Doit.Set(config.NS, doctl.ArgFormat, "Domain,RecordCount,NSServers")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := RunDomainList(config)
+		assert.NoError(t, err)
+		assert.Equal(t, "example.com    3    ns1.digitalocean.com,ns2.digitalocean.com\n", buf.String())
+	})
+	// without --with-details, the records are not fetched and the columns are not shown
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.domains.EXPECT().List().Return(testDomainList, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+
+		err := RunDomainList(config)
+		assert.NoError(t, err)
+		assert.NotContains(t, buf.String(), "Record Count")
+		assert.NotContains(t, buf.String(), "NS Servers")
+	})
+}
+
 func TestDomainsGet(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		tm.domains.EXPECT().Get("example.com").Return(&testDomain, nil)
@@ -106,6 +169,65 @@ func TestRecordsList(t *testing.T) {
 	})
 }
 
+func TestRecordsListDataContains(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		records := do.DomainRecords{
+			{DomainRecord: &godo.DomainRecord{ID: 1, Type: "A", Name: "@", Data: "192.0.2.1"}},
+			{DomainRecord: &godo.DomainRecord{ID: 2, Type: "A", Name: "api", Data: "192.0.2.10"}},
+			{DomainRecord: &godo.DomainRecord{ID: 3, Type: "CNAME", Name: "www", Data: "Web.Example.com"}},
+		}
+		tm.domains.EXPECT().Records("example.com").Return(records, nil).Times(2)
+
+		for _, tt := range []struct {
+			contains string
+			expected string
+		}{
+			{contains: "192.0.2.1", expected: "1\n2\n"},
+			{contains: "web.example", expected: "3\n"},
+		} {
+			var buf bytes.Buffer
+			config.Out = &buf
+			config.Args = []string{"example.com"}
+			config.Doit.Set(config.NS, doctl.ArgRecordDataContains, tt.contains)
+			config.Doit.Set(config.NS, doctl.ArgFormat, "ID")
+			config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+			err := RunRecordList(config)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, buf.String())
+		}
+	})
+}
+
+func TestRecordsListBIND(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		records := do.DomainRecords{
+			{DomainRecord: &godo.DomainRecord{Type: "A", Name: "@", Data: "192.0.2.1", TTL: 3600}},
+			{DomainRecord: &godo.DomainRecord{Type: "CNAME", Name: "www", Data: "@", TTL: 43200}},
+			{DomainRecord: &godo.DomainRecord{Type: "MX", Name: "@", Data: "mail.example.com", Priority: 10, TTL: 1800}},
+			{DomainRecord: &godo.DomainRecord{Type: "TXT", Name: "@", Data: "v=spf1 -all", TTL: 3600}},
+		}
+		tm.domains.EXPECT().Records("example.com").Return(records, nil)
+
+		origOutput := Output
+		Output = "bind"
+		defer func() { Output = origOutput }()
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Args = append(config.Args, "example.com")
+
+		err := RunRecordList(config)
+		assert.NoError(t, err)
+		expected := `example.com.     3600  IN A     192.0.2.1
+www.example.com. 43200 IN CNAME example.com.
+example.com.     1800  IN MX    10 mail.example.com.
+example.com.     3600  IN TXT   "v=spf1 -all"
+`
+		assert.Equal(t, expected, buf.String())
+	})
+}
+
 func TestRecordList_RequiredArguments(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		err := RunRecordList(config)
@@ -151,6 +273,91 @@ func TestRecordsDelete(t *testing.T) {
 	})
 }
 
+func TestRecordsDeleteAllByType(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		records := do.DomainRecords{
+			{DomainRecord: &godo.DomainRecord{ID: 1, Type: "A"}},
+			{DomainRecord: &godo.DomainRecord{ID: 2, Type: "TXT"}},
+			{DomainRecord: &godo.DomainRecord{ID: 3, Type: "TXT"}},
+		}
+		tm.domains.EXPECT().Records("example.com").Return(records, nil)
+		tm.domains.EXPECT().DeleteRecord("example.com", 2).Return(nil)
+		tm.domains.EXPECT().DeleteRecord("example.com", 3).Return(nil)
+
+		config.Args = append(config.Args, "example.com")
+		config.Doit.Set(config.NS, doctl.ArgRecordDeleteAll, true)
+		config.Doit.Set(config.NS, doctl.ArgRecordType, "txt")
+		config.Doit.Set(config.NS, doctl.ArgForce, true)
+
+		err := RunRecordDelete(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestRecordsDeleteAllContinuesAfterFailure(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		records := do.DomainRecords{
+			{DomainRecord: &godo.DomainRecord{ID: 1, Type: "CNAME"}},
+			{DomainRecord: &godo.DomainRecord{ID: 2, Type: "CNAME"}},
+			{DomainRecord: &godo.DomainRecord{ID: 3, Type: "CNAME"}},
+		}
+		tm.domains.EXPECT().Records("example.com").Return(records, nil)
+		tm.domains.EXPECT().DeleteRecord("example.com", 1).Return(nil)
+		tm.domains.EXPECT().DeleteRecord("example.com", 2).Return(errors.New("boom"))
+		tm.domains.EXPECT().DeleteRecord("example.com", 3).Return(nil)
+
+		config.Args = append(config.Args, "example.com")
+		config.Doit.Set(config.NS, doctl.ArgRecordDeleteAll, true)
+		config.Doit.Set(config.NS, doctl.ArgRecordType, "CNAME")
+		config.Doit.Set(config.NS, doctl.ArgForce, true)
+
+		err := RunRecordDelete(config)
+		assert.EqualError(t, err, "failed to delete 1 of 3 records of example.com:\nrecord 2: boom")
+	})
+}
+
+func TestRecordsDeleteAllWithoutTypeKeepsSOAAndApexNS(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		records := do.DomainRecords{
+			{DomainRecord: &godo.DomainRecord{ID: 1, Type: "SOA", Name: "@"}},
+			{DomainRecord: &godo.DomainRecord{ID: 2, Type: "NS", Name: "@"}},
+			{DomainRecord: &godo.DomainRecord{ID: 3, Type: "NS", Name: "@"}},
+		}
+		tm.domains.EXPECT().Records("example.com").Return(records, nil)
+
+		config.Args = append(config.Args, "example.com")
+		config.Doit.Set(config.NS, doctl.ArgRecordDeleteAll, true)
+
+		var err error
+		notices := captureStderr(t, func() { err = RunRecordDelete(config) })
+		assert.NoError(t, err)
+		assert.Contains(t, notices, "No matching records found for example.com")
+	})
+}
+
+func TestRecordsDeleteAllWithoutTypeRequiresTypedConfirmation(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.domains.EXPECT().Records("example.com").Return(testRecordList, nil)
+
+		config.Args = append(config.Args, "example.com")
+		config.Doit.Set(config.NS, doctl.ArgRecordDeleteAll, true)
+		config.Doit.Set(config.NS, doctl.ArgForce, true)
+
+		err := RunRecordDelete(config)
+		assert.Error(t, err)
+	})
+}
+
+func TestRecordsDeleteAllWithRecordIDs(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Args = append(config.Args, "example.com", "1")
+		config.Doit.Set(config.NS, doctl.ArgRecordDeleteAll, true)
+
+		err := RunRecordDelete(config)
+		assert.Error(t, err)
+	})
+}
+
 func TestRecordsUpdate(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		port := 0
@@ -169,3 +376,122 @@ func TestRecordsUpdate(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func writeRecordsCSV(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "records.csv")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestRecordsImportCSV(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		path := writeRecordsCSV(t, "type,name,data,ttl,priority\nA,@,192.0.2.1,3600,\nMX,@,mail.example.com.,,10\nTXT,@,v=spf1 -all,,\n")
+
+		existing := do.DomainRecords{
+			{DomainRecord: &godo.DomainRecord{ID: 7, Type: "A", Name: "@", Data: "192.0.2.99"}},
+			{DomainRecord: &godo.DomainRecord{ID: 8, Type: "A", Name: "www", Data: "192.0.2.99"}},
+		}
+		tm.domains.EXPECT().Records("example.com").Return(existing, nil)
+		tm.domains.EXPECT().DeleteRecord("example.com", 7).Return(nil)
+		tm.domains.EXPECT().CreateRecord("example.com", &do.DomainRecordEditRequest{Type: "A", Name: "@", Data: "192.0.2.1", TTL: 3600}).
+			Return(&do.DomainRecord{DomainRecord: &godo.DomainRecord{ID: 10}}, nil)
+		tm.domains.EXPECT().CreateRecord("example.com", &do.DomainRecordEditRequest{Type: "MX", Name: "@", Data: "mail.example.com.", TTL: 1800, Priority: 10}).
+			Return(nil, errors.New("boom"))
+		tm.domains.EXPECT().CreateRecord("example.com", &do.DomainRecordEditRequest{Type: "TXT", Name: "@", Data: "v=spf1 -all", TTL: 1800}).
+			Return(&do.DomainRecord{DomainRecord: &godo.DomainRecord{ID: 12}}, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Args = append(config.Args, "example.com")
+		config.Doit.Set(config.NS, doctl.ArgRecordImportFile, path)
+		config.Doit.Set(config.NS, doctl.ArgRecordImportOverwrite, true)
+
+		err := RunRecordImportCSV(config)
+		assert.EqualError(t, err, "1 of the changes for example.com failed")
+		assert.Equal(t, `line 2: deleted record 7 (A @ 192.0.2.99)
+line 2: created record 10 (A @ 192.0.2.1)
+line 3: failed to create MX @ mail.example.com.: boom
+line 4: created record 12 (TXT @ v=spf1 -all)
+`, buf.String())
+	})
+}
+
+func TestRecordsImportCSVDryRun(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		path := writeRecordsCSV(t, "CNAME,www,@,,\n")
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Args = append(config.Args, "example.com")
+		config.Doit.Set(config.NS, doctl.ArgRecordImportFile, path)
+		config.Doit.Set(config.NS, doctl.ArgDryRun, true)
+
+		err := RunRecordImportCSV(config)
+		assert.NoError(t, err)
+		assert.Equal(t, "line 1: would create CNAME www @\n", buf.String())
+	})
+}
+
+func TestRecordsImportCSVInvalid(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		path := writeRecordsCSV(t, "SRV,_sip._tcp,sip.example.com.,,\nA,,192.0.2.1,,\nA,@,192.0.2.1,10,\n")
+
+		config.Args = append(config.Args, "example.com")
+		config.Doit.Set(config.NS, doctl.ArgRecordImportFile, path)
+
+		err := RunRecordImportCSV(config)
+		assert.EqualError(t, err, `invalid records file:
+line 1: invalid record type "SRV"; valid types are A, AAAA, CNAME, MX, NS, TXT
+line 2: missing record name
+line 3: invalid TTL "10"; must be a number of seconds no less than 30`)
+	})
+}
+
+func TestRecordsCreate_MX(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		dcer := &do.DomainRecordEditRequest{Type: "MX", Name: "@", Data: "mail.example.com.", Priority: 10}
+		tm.domains.EXPECT().CreateRecord("example.com", dcer).Return(&testRecord, nil)
+
+		config.Doit.Set(config.NS, doctl.ArgRecordType, "MX")
+		config.Doit.Set(config.NS, doctl.ArgRecordName, "@")
+		config.Doit.Set(config.NS, doctl.ArgRecordMXPriority, 10)
+		config.Doit.Set(config.NS, doctl.ArgRecordMXExchange, "mail.example.com.")
+
+		config.Args = append(config.Args, "example.com")
+
+		err := RunRecordCreate(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestRecordsCreate_SRV(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		port := 5060
+		dcer := &do.DomainRecordEditRequest{Type: "SRV", Name: "_sip._tcp", Data: "sip.example.com.", Priority: 10, Weight: 5, Port: &port}
+		tm.domains.EXPECT().CreateRecord("example.com", dcer).Return(&testRecord, nil)
+
+		config.Doit.Set(config.NS, doctl.ArgRecordType, "SRV")
+		config.Doit.Set(config.NS, doctl.ArgRecordName, "_sip._tcp")
+		config.Doit.Set(config.NS, doctl.ArgRecordSRVPriority, 10)
+		config.Doit.Set(config.NS, doctl.ArgRecordSRVWeight, 5)
+		config.Doit.Set(config.NS, doctl.ArgRecordSRVPort, 5060)
+		config.Doit.Set(config.NS, doctl.ArgRecordSRVTarget, "sip.example.com.")
+
+		config.Args = append(config.Args, "example.com")
+
+		err := RunRecordCreate(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestRecordsCreate_SRVFlagsRejectedForOtherTypes(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Doit.Set(config.NS, doctl.ArgRecordType, "A")
+		config.Doit.Set(config.NS, doctl.ArgRecordSRVTarget, "sip.example.com.")
+
+		config.Args = append(config.Args, "example.com")
+
+		err := RunRecordCreate(config)
+		assert.Error(t, err)
+	})
+}
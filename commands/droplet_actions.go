@@ -73,9 +73,9 @@ You can use Droplet actions to perform tasks on a Droplet, such as rebooting, re
 	cmdDropletActionEnableBackups := CmdBuilder(cmd, RunDropletActionEnableBackups,
 		"enable-backups <droplet-id>", "Enable backups on a Droplet", `Enables backups on a Droplet. This automatically creates and stores a disk image of the Droplet. By default, backups happen daily.`, Writer,
 		displayerType(&displayers.Action{}))
-	AddStringFlag(cmdDropletActionEnableBackups, doctl.ArgDropletBackupPolicyPlan, "", "", `Backup policy frequency plan.`)
-	AddStringFlag(cmdDropletActionEnableBackups, doctl.ArgDropletBackupPolicyWeekday, "", "", `Backup policy weekday.`)
-	AddIntFlag(cmdDropletActionEnableBackups, doctl.ArgDropletBackupPolicyHour, "", 0, `Backup policy hour.`)
+	AddStringFlag(cmdDropletActionEnableBackups, doctl.ArgDropletBackupPolicyPlan, "", "", "Backup policy frequency plan, `daily` or `weekly`. If omitted, the default policy is used.")
+	AddStringFlag(cmdDropletActionEnableBackups, doctl.ArgDropletBackupPolicyWeekday, "", "", "Backup policy weekday for the `weekly` plan, such as `SUN`.")
+	AddIntFlag(cmdDropletActionEnableBackups, doctl.ArgDropletBackupPolicyHour, "", 0, "Backup policy hour in UTC at which the backup window starts.")
 	AddBoolFlag(cmdDropletActionEnableBackups, doctl.ArgCommandWait, "", false, "Wait for action to complete")
 	cmdDropletActionEnableBackups.Example = `The following example enables backups on a Droplet with the ID ` + "`" + `386734086` + "` with a backup policy flag" + `: doctl compute droplet-action enable-backups 386734086 --backup-policy-plan weekly --backup-policy-weekday SUN --backup-policy-hour 4`
 
@@ -243,7 +243,7 @@ func RunDropletActionGet(c *CmdConfig) error {
 	return performAction(c, fn)
 }
 
-// RunDropletActionEnableBackups disables backups for a droplet.
+// RunDropletActionEnableBackups enables backups for a droplet.
 func RunDropletActionEnableBackups(c *CmdConfig) error {
 	fn := func(das do.DropletActionsService) (*do.Action, error) {
 		err := ensureOneArg(c)
@@ -256,7 +256,10 @@ func RunDropletActionEnableBackups(c *CmdConfig) error {
 		}
 
 		policy, err := readDropletBackupPolicy(c)
-		if err == nil && policy != nil {
+		if err != nil {
+			return nil, err
+		}
+		if policy != nil {
 			return das.EnableBackupsWithPolicy(id, policy)
 		}
 
@@ -14,6 +14,7 @@ limitations under the License.
 package commands
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/digitalocean/doctl"
@@ -79,6 +80,46 @@ func TestDropletActionsEnableBackups(t *testing.T) {
 		err := RunDropletActionEnableBackups(config)
 		require.NoError(t, err)
 	})
+	// A daily plan is sent without a weekday.
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		policy := &godo.DropletBackupPolicyRequest{
+			Plan: "daily",
+			Hour: godo.PtrTo(4),
+		}
+
+		tm.dropletActions.EXPECT().EnableBackupsWithPolicy(1, policy).Times(1).Return(&testAction, nil)
+
+		config.Args = append(config.Args, "1")
+		config.Doit.Set(config.NS, doctl.ArgDropletBackupPolicyPlan, policy.Plan)
+		config.Doit.Set(config.NS, doctl.ArgDropletBackupPolicyWeekday, "SAT")
+		config.Doit.Set(config.NS, doctl.ArgDropletBackupPolicyHour, 4)
+
+		err := RunDropletActionEnableBackups(config)
+		require.NoError(t, err)
+	})
+	// Errors reading the policy flags abort instead of enabling backups
+	// with the default policy.
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Args = append(config.Args, "1")
+		config.Doit.Set(config.NS, doctl.ArgDropletBackupPolicyPlan, "weekly")
+		config.Doit = &failingGetIntConfig{Config: config.Doit, key: doctl.ArgDropletBackupPolicyHour}
+
+		err := RunDropletActionEnableBackups(config)
+		assert.EqualError(t, err, "invalid value for --backup-policy-hour")
+	})
+}
+
+// failingGetIntConfig is a doctl.Config whose GetInt fails for one key.
+type failingGetIntConfig struct {
+	doctl.Config
+	key string
+}
+
+func (c *failingGetIntConfig) GetInt(ns, key string) (int, error) {
+	if key == c.key {
+		return 0, errors.New("invalid value for --" + key)
+	}
+	return c.Config.GetInt(ns, key)
 }
 
 func TestDropletActionsDisableBackups(t *testing.T) {
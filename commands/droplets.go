@@ -14,21 +14,31 @@ limitations under the License.
 package commands
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
 	"os"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
 	"sync"
 	"text/template"
+	"time"
 
 	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/commands/displayers"
 	"github.com/digitalocean/doctl/do"
 	"github.com/digitalocean/godo"
+	"github.com/digitalocean/godo/metrics"
 	"github.com/gobwas/glob"
 	"github.com/google/uuid"
 	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+	"sigs.k8s.io/yaml"
 )
 
 // Droplet creates the droplet command.
@@ -57,6 +67,8 @@ func Droplet() *Command {
 - The tags assigned to the Droplet
 - A list of features enabled for the Droplet, such as ` + "`" + `backups` + "`" + `, ` + "`" + `ipv6` + "`" + `, ` + "`" + `monitoring` + "`" + `, and ` + "`" + `private_networking` + "`" + `
 - The IDs of block storage volumes attached to the Droplet
+
+The ` + "`" + `BackupEnabled` + "`" + `, ` + "`" + `BackupPolicy` + "`" + `, and ` + "`" + `NextBackupWindow` + "`" + ` columns are not shown by default. Request them with the ` + "`" + `--format` + "`" + ` flag to see the Droplet's backup schedule.
 	`
 	cmdDropletActions := CmdBuilder(cmd, RunDropletActions, "actions <droplet-id>", "List Droplet actions", `Retrieves a list of previous actions taken on the Droplet, such as reboots, resizes, and snapshots actions.`, Writer,
 		aliasOpt("a"), displayerType(&displayers.Action{}))
@@ -66,6 +78,30 @@ func Droplet() *Command {
 		aliasOpt("b"), displayerType(&displayers.Image{}))
 	cmdDropletBackups.Example = `The following example retrieves a list of backups for a Droplet with the ID ` + "`" + `386734086` + "`" + `: doctl compute droplet backups 386734086`
 
+	cmdDropletBandwidth := CmdBuilder(cmd, RunDropletBandwidthMetrics, "bandwidth <droplet-id>", "Retrieve Droplet bandwidth metrics", `Retrieves inbound and outbound bandwidth usage for a Droplet over a time range, in megabits per second. The 95th percentile of each direction is printed after the table.
+
+Metrics are collected by the DigitalOcean metrics agent, so the Droplet must have monitoring enabled.`, Writer,
+		displayerType(&displayers.Metrics{}))
+	addMetricsTimeRangeFlags(cmdDropletBandwidth)
+	AddStringFlag(cmdDropletBandwidth, doctl.ArgMetricsInterface, "", "public", "The network interface to retrieve metrics for. Possible values: `public` or `private`.")
+	cmdDropletBandwidth.Example = `The following example retrieves the public bandwidth usage of a Droplet with the ID ` + "`" + `386734086` + "`" + ` for the first six hours of January 1st, 2026: doctl compute droplet bandwidth 386734086 --start 2026-01-01T00:00:00Z --end 2026-01-01T06:00:00Z`
+
+	cmdDropletCPU := CmdBuilder(cmd, RunDropletCPUMetrics, "cpu <droplet-id>", "Retrieve Droplet CPU metrics", `Retrieves the CPU utilization of a Droplet over a time range, as a percentage of its total CPU capacity.
+
+Metrics are collected by the DigitalOcean metrics agent, so the Droplet must have monitoring enabled. Use `+"`"+`--output json`+"`"+` to feed the time series into other graphing tools.`, Writer,
+		displayerType(&displayers.Metrics{}))
+	addMetricsTimeRangeFlags(cmdDropletCPU)
+	addMetricsResolutionFlag(cmdDropletCPU)
+	cmdDropletCPU.Example = `The following example retrieves the CPU utilization of a Droplet with the ID ` + "`" + `386734086` + "`" + ` over the last hour, averaged over five minute intervals: doctl compute droplet cpu 386734086 --resolution 5m`
+
+	cmdDropletMemory := CmdBuilder(cmd, RunDropletMemoryMetrics, "memory <droplet-id>", "Retrieve Droplet memory metrics", `Retrieves the total, used, free, and cached memory of a Droplet over a time range, in MiB. Used memory is the total memory minus the memory available to new processes.
+
+Metrics are collected by the DigitalOcean metrics agent, so the Droplet must have monitoring enabled. Use `+"`"+`--output json`+"`"+` to feed the time series into other graphing tools.`, Writer,
+		displayerType(&displayers.Metrics{}))
+	addMetricsTimeRangeFlags(cmdDropletMemory)
+	addMetricsResolutionFlag(cmdDropletMemory)
+	cmdDropletMemory.Example = `The following example retrieves the memory usage of a Droplet with the ID ` + "`" + `386734086` + "`" + ` since midnight on January 1st, 2026, averaged over one hour intervals: doctl compute droplet memory 386734086 --start 2026-01-01T00:00:00Z --resolution 1h`
+
 	dropletCreateLongDesc := `Creates a new Droplet on your account. The command requires values for the ` + "`" + `--size` + "`" + `, and ` + "`" + `--image` + "`" + ` flags.
 
 To retrieve a list of size slugs, use the ` + "`" + `doctl compute size list` + "`" + ` command. To retrieve a list of image slugs, use the ` + "`" + `doctl compute image list` + "`" + ` command.
@@ -77,7 +113,10 @@ If you do not specify a region, the Droplet is created in the default region for
 	AddStringSliceFlag(cmdDropletCreate, doctl.ArgSSHKeys, "", []string{}, "A list of SSH key IDs or fingerprints to embed in the Droplet's root account upon creation")
 	AddStringFlag(cmdDropletCreate, doctl.ArgUserData, "", "", "A shell script to run on the Droplet's first boot")
 	AddStringFlag(cmdDropletCreate, doctl.ArgUserDataFile, "", "", "The path to a file containing a shell script or Cloud-init YAML file to run on the Droplet's first boot. Example: `path/to/file.yaml`")
+	AddStringSliceFlag(cmdDropletCreate, doctl.ArgCloudInitAddPackage, "", []string{}, "A package to install on the Droplet's first boot using cloud-init. Can be specified multiple times. If `--user-data` or `--user-data-file` contains a cloud-config document, the packages are added to it.")
 	AddBoolFlag(cmdDropletCreate, doctl.ArgCommandWait, "", false, "Instructs the terminal to wait for the action to complete before returning access to the user")
+	AddStringFlag(cmdDropletCreate, doctl.ArgWaitForHTTP, "", "", "After the Droplet is active, polls the given URL until it responds with a 2xx status. The URL may use Go template syntax to refer to the new Droplet, for example `http://{{.PublicIPv4}}/healthz`. Implies `--wait`.")
+	AddDurationFlag(cmdDropletCreate, doctl.ArgWaitForHTTPTimeout, "", 10*time.Minute, "How long to wait for the `--wait-for-http` URL to respond before giving up. Valid time units are \"s\", \"m\", \"h\".")
 	AddStringFlag(cmdDropletCreate, doctl.ArgRegionSlug, "", "", "A `slug` specifying the region to create the Droplet in, such as `nyc1`. Use the `doctl compute region list` command for a list of valid regions.")
 	AddStringFlag(cmdDropletCreate, doctl.ArgSizeSlug, "", "", "A `slug` indicating the Droplet's number of vCPUs, RAM, and disk size. For example, `s-1vcpu-1gb` specifies a Droplet with one vCPU and 1 GiB of RAM. The disk size is defined by the slug's plan. Run `doctl compute size list` for a list of valid size slugs and their disk sizes.",
 		requiredOpt())
@@ -85,17 +124,24 @@ If you do not specify a region, the Droplet is created in the default region for
 	AddStringFlag(cmdDropletCreate, doctl.ArgDropletBackupPolicyPlan, "", "", `Backup policy frequency plan.`)
 	AddStringFlag(cmdDropletCreate, doctl.ArgDropletBackupPolicyWeekday, "", "", `Backup policy weekday.`)
 	AddIntFlag(cmdDropletCreate, doctl.ArgDropletBackupPolicyHour, "", 0, `Backup policy hour.`)
-	AddBoolFlag(cmdDropletCreate, doctl.ArgIPv6, "", false, "Enables IPv6 support and assigns an IPv6 address to the Droplet")
-	AddBoolFlag(cmdDropletCreate, doctl.ArgPrivateNetworking, "", false, "Enables private networking for the Droplet by provisioning it inside of your account's default VPC for the region")
-	AddBoolFlag(cmdDropletCreate, doctl.ArgMonitoring, "", false, "Installs the DigitalOcean agent for additional monitoring")
+	AddBoolFlag(cmdDropletCreate, doctl.ArgIPv6, "", false, "Enables IPv6 support and assigns an IPv6 address to the Droplet. A warning is printed if the region does not support IPv6.")
+	AddBoolFlag(cmdDropletCreate, doctl.ArgPrivateNetworking, "", false, "(Deprecated) This flag has no effect. All new Droplets are placed in a VPC network, the region's default VPC unless `--vpc-uuid` is set.")
+	AddBoolFlag(cmdDropletCreate, doctl.ArgMonitoring, "", false, "Installs the DigitalOcean agent for additional monitoring. A warning is printed if the region does not support monitoring.")
 	AddStringFlag(cmdDropletCreate, doctl.ArgImage, "", "", "An ID or slug specifying the image to use to create the Droplet, such as `ubuntu-20-04-x64`. Use the commands under `doctl compute image` to find additional images.",
 		requiredOpt())
 	AddStringFlag(cmdDropletCreate, doctl.ArgTagName, "", "", "Applies a tag to the Droplet")
-	AddStringFlag(cmdDropletCreate, doctl.ArgVPCUUID, "", "", "The UUID of a non-default VPC to create the Droplet in")
+	AddStringFlag(cmdDropletCreate, doctl.ArgVPCUUID, "", "", "The UUID of a non-default VPC to create the Droplet in. The VPC must be in the region set with `--region`.")
 	AddStringFlag(cmdDropletCreate, doctl.ArgProjectID, "", "", "The UUID of the project to assign the Droplet to")
 	AddStringSliceFlag(cmdDropletCreate, doctl.ArgTagNames, "", []string{}, "Applies a list of tags to the Droplet")
+	cmdDropletCreate.RegisterFlagCompletionFunc(doctl.ArgTagNames, tagNamesCompletionFunc)
+	AddStringSliceFlag(cmdDropletCreate, doctl.ArgTag, "", []string{}, "Applies a tag to the Droplet. Can be repeated to apply multiple tags, for example `--tag web --tag prod`. Combined with any tags from `--tag-name` and `--tag-names`.")
+	cmdDropletCreate.RegisterFlagCompletionFunc(doctl.ArgTag, tagNamesCompletionFunc)
 	AddBoolFlag(cmdDropletCreate, doctl.ArgDropletAgent, "", false, "Specifies whether or not the Droplet monitoring agent should be installed. By default, the agent is installed on new Droplets but installation errors are ignored. Set `--droplet-agent=false` to prevent installation. Set to `true` to make installation errors fatal.")
 	AddStringSliceFlag(cmdDropletCreate, doctl.ArgVolumeList, "", []string{}, "A list of block storage volume IDs to attach to the Droplet")
+	AddStringSliceFlag(cmdDropletCreate, doctl.ArgVolumeID, "", []string{}, "The ID of a block storage volume to attach to the Droplet. Can be specified multiple times. Each volume must exist and be in the same region as the Droplet. If `--region` is not set, the Droplet is created in the volumes' region.")
+	AddBoolFlag(cmdDropletCreate, doctl.ArgDropletWithURLs, "", false, "Adds a `ConsoleURL` column with the link to each new Droplet in the control panel. The column can also be requested with `--format`.")
+	addTerraformImportFlag(cmdDropletCreate)
+	AddStringFlag(cmdDropletCreate, doctl.ArgDropletGenerateName, "", "", "Generates a unique Droplet name by appending a random suffix to the given prefix, for example `web-k7x2m`. Any positional name arguments are ignored.")
 	cmdDropletCreate.Example = `The following example creates a Droplet named ` + "`" + `example-droplet` + "`" + ` with a two vCPUs, two GiB of RAM, and 20 GBs of disk space. The Droplet is created in the ` + "`" + `nyc1` + "`" + ` region and is based on the ` + "`" + `ubuntu-20-04-x64` + "`" + ` image. Additionally, the command uses the ` + "`" + `--user-data` + "`" + ` flag to run a Bash script the first time the Droplet boots up:` + "\n\n" + `doctl compute droplet create example-droplet --size s-2vcpu-2gb --image ubuntu-20-04-x64 --region nyc1 --user-data $'#!/bin/bash\n touch /root/example.txt; sudo apt update;sudo snap install doctl'` + "\n\n" + "Please note: In Windows Powershell, the example command would be the following instead: " + "\n\n" + "doctl compute droplet create example-droplet --size s-2vcpu-2gb --image ubuntu-20-04-x64 --region nyc1  --user-data \"#!/bin/bash`n touch /root/example.txt; sudo apt update;sudo snap install doctl\""
 
 	cmdRunDropletDelete := CmdBuilder(cmd, RunDropletDelete, "delete <droplet-id|droplet-name>...", "Permanently delete a Droplet", `Permanently deletes a Droplet. This is irreversible.`, Writer,
@@ -107,18 +153,31 @@ If you do not specify a region, the Droplet is created in the default region for
 	cmdRunDropletGet := CmdBuilder(cmd, RunDropletGet, "get <droplet-id|droplet-name>", "Retrieve information about a Droplet", `Retrieves information about a Droplet, including:`+dropletDetails, Writer,
 		aliasOpt("g"), displayerType(&displayers.Droplet{}))
 	AddStringFlag(cmdRunDropletGet, doctl.ArgTemplate, "", "", "Go template format. Sample values: `{{.ID}}`, `{{.Name}}`, `{{.Memory}}`, `{{.Region.Name}}`, `{{.Image}}`, `{{.Tags}}`")
-	cmdRunDropletGet.Example = `The following example retrieves information about a Droplet with the ID ` + "`" + `386734086` + "`" + `. The command also uses the ` + "`" + `--format` + "`" + ` flag to only return the Droplet's name, ID, and public IPv4 address: doctl compute droplet get 386734086 --format Name,ID,PublicIPv4`
+	cmdRunDropletGet.Example = `The following example retrieves information about a Droplet with the ID ` + "`" + `386734086` + "`" + `. The command also uses the ` + "`" + `--format` + "`" + ` flag to only return the Droplet's name, ID, and public IPv4 address: doctl compute droplet get 386734086 --format Name,ID,PublicIPv4
+
+The following example retrieves information about a Droplet named ` + "`" + `example-droplet` + "`" + `. If more than one Droplet has that name, the command displays all of them: doctl compute droplet get example-droplet`
 
 	cmdDropletKernels := CmdBuilder(cmd, RunDropletKernels, "kernels <droplet-id>", "List available Droplet kernels", `Retrieves a list of all kernels available to a Droplet. This command is only available for Droplets with externally managed kernels. All Droplets created after March 2017 have internally managed kernels by default.`, Writer,
 		aliasOpt("k"), displayerType(&displayers.Kernel{}))
 	cmdDropletKernels.Example = `The following example retrieves a list of available kernels for a Droplet with the ID ` + "`" + `386734086` + "`" + `: doctl compute droplet kernels 386734086`
 
-	cmdRunDropletList := CmdBuilder(cmd, RunDropletList, "list [GLOB]", "List Droplets on your account", `Retrieves a list of Droplets on your account, including the following information about each:`+dropletDetails, Writer,
+	cmdRunDropletList := CmdBuilder(cmd, RunDropletList, "list [GLOB]", "List Droplets on your account", `Retrieves a list of Droplets on your account. Instead of a list of columns, `+"`"+`--format`+"`"+` also accepts `+"`"+`ssh-config`+"`"+`, which prints an SSH client configuration `+"`"+`Host`+"`"+` entry for each Droplet with a public IPv4 address.
+
+The list includes the following information about each Droplet:`+dropletDetails+`
+
+Only the first three tags of each Droplet are shown, followed by `+"`"+`...`+"`"+` if it has more. Request the `+"`"+`Tags`+"`"+` column with `+"`"+`--format`+"`"+` to show all of them.
+
+The API does not expose whether the Droplet agent, which provides console access, can reach the platform, so the list cannot show its connectivity. The `+"`"+`--with-agent-info`+"`"+` flag only reflects the metrics agent, and cannot show its version because the metrics API does not report it.`, Writer,
 		aliasOpt("ls"), displayerType(&displayers.Droplet{}))
 	AddStringFlag(cmdRunDropletList, doctl.ArgRegionSlug, "", "", "Retrieves a list of Droplets in a specified region")
 	AddStringFlag(cmdRunDropletList, doctl.ArgTagName, "", "", "Retrieves a list of Droplets with the specified tag name")
 	AddBoolFlag(cmdRunDropletList, doctl.ArgGPUs, "", false, "List GPU Droplets only. By default, only non-GPU Droplets are returned.")
-	cmdRunDropletList.Example = `The following example retrieves a list of all Droplets in the ` + "`" + `nyc1` + "`" + ` region: doctl compute droplet list --region nyc1`
+	AddBoolFlag(cmdRunDropletList, doctl.ArgResolveNames, "", false, "Displays attached volumes as `<name>(<id>)` in the `Volumes` column. This makes one additional API request per attached volume.")
+	AddBoolFlag(cmdRunDropletList, doctl.ArgDropletWithAgentInfo, "", false, "Adds a `MetricsAgent` column showing whether the metrics agent installed with `--monitoring` is reporting metrics from each Droplet, or `N/A` if it is not. This is not the Droplet agent that provides console access. Droplets whose metrics can't be retrieved are also shown as `N/A`. The metrics API does not report the agent's version, so there is no `AgentVersion` column. This makes one additional API request per Droplet.")
+	AddStringFlag(cmdRunDropletList, doctl.ArgSortBy, "", "", "Sort the Droplets by the given field. Possible values: `name`, `status`, `region`, `size`, `memory`, `vcpus`, `created`. By default, Droplets are listed in creation order. Use `--format` with the `CreatedAt` column to show when each Droplet was created.")
+	cmdRunDropletList.Example = `The following example retrieves a list of all Droplets in the ` + "`" + `nyc1` + "`" + ` region: doctl compute droplet list --region nyc1
+
+The following example appends a Host entry for each Droplet tagged ` + "`" + `web` + "`" + ` to your SSH configuration: doctl compute droplet list --tag-name web --format ssh-config >> ~/.ssh/config`
 
 	cmdDropletNeighbors := CmdBuilder(cmd, RunDropletNeighbors, "neighbors <droplet-id>", "List a Droplet's neighbors on your account", `Lists your Droplets that are on the same physical hardware, including the following details:`+dropletDetails, Writer,
 		aliasOpt("n"), displayerType(&displayers.Droplet{}))
@@ -180,10 +239,192 @@ func RunDropletBackups(c *CmdConfig) error {
 	return c.Display(item)
 }
 
+// RunDropletBandwidthMetrics retrieves the inbound and outbound bandwidth
+// usage of a droplet.
+func RunDropletBandwidthMetrics(c *CmdConfig) error {
+	id, err := getDropletIDArg(c.NS, c.Args)
+	if err != nil {
+		return err
+	}
+
+	iface, err := c.Doit.GetString(c.NS, doctl.ArgMetricsInterface)
+	if err != nil {
+		return err
+	}
+	if iface != "public" && iface != "private" {
+		return fmt.Errorf("invalid value %q for --%s; possible values: public, private", iface, doctl.ArgMetricsInterface)
+	}
+
+	start, end, err := metricsTimeRange(c)
+	if err != nil {
+		return err
+	}
+
+	ms := c.Monitoring()
+	series := make(map[string][]metrics.SamplePair)
+	for direction, name := range map[string]string{"inbound": "Inbound", "outbound": "Outbound"} {
+		resp, err := ms.GetDropletBandwidth(strconv.Itoa(id), iface, direction, start, end)
+		if err != nil {
+			return err
+		}
+		series[name] = firstMetricSamples(resp)
+	}
+
+	item := &displayers.Metrics{
+		Series: []string{"Inbound", "Outbound"},
+		Points: metricPoints(series),
+	}
+	if err := c.Display(item); err != nil {
+		return err
+	}
+
+	if Output != "json" && len(item.Points) > 0 {
+		notice("95th percentile: %.2f Mbps inbound, %.2f Mbps outbound",
+			metricPercentile(series["Inbound"], 95), metricPercentile(series["Outbound"], 95))
+	}
+
+	return nil
+}
+
+// RunDropletCPUMetrics retrieves the CPU utilization of a droplet.
+func RunDropletCPUMetrics(c *CmdConfig) error {
+	id, err := getDropletIDArg(c.NS, c.Args)
+	if err != nil {
+		return err
+	}
+
+	start, end, err := metricsTimeRange(c)
+	if err != nil {
+		return err
+	}
+
+	resolution, err := c.Doit.GetDuration(c.NS, doctl.ArgMetricsResolution)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.Monitoring().GetDropletCPU(strconv.Itoa(id), start, end)
+	if err != nil {
+		return err
+	}
+
+	points := metricPoints(map[string][]metrics.SamplePair{"Utilization": dropletCPUUtilization(resp)})
+	item := &displayers.Metrics{
+		Series: []string{"Utilization"},
+		Points: resampleMetricPoints(points, resolution),
+	}
+	return c.Display(item)
+}
+
+// dropletCPUUtilization converts the cumulative per-mode CPU time counters
+// returned by the monitoring API into the percentage of CPU time spent
+// outside the idle mode between consecutive samples.
+func dropletCPUUtilization(resp *godo.MetricsResponse) []metrics.SamplePair {
+	if resp == nil {
+		return nil
+	}
+
+	total := make(map[metrics.Time]float64)
+	idle := make(map[metrics.Time]float64)
+	for _, stream := range resp.Data.Result {
+		for _, s := range stream.Values {
+			total[s.Timestamp] += float64(s.Value)
+			if stream.Metric["mode"] == "idle" {
+				idle[s.Timestamp] += float64(s.Value)
+			}
+		}
+	}
+
+	timestamps := make([]metrics.Time, 0, len(total))
+	for ts := range total {
+		timestamps = append(timestamps, ts)
+	}
+	slices.Sort(timestamps)
+
+	var utilization []metrics.SamplePair
+	for i := 1; i < len(timestamps); i++ {
+		prev, cur := timestamps[i-1], timestamps[i]
+		delta := total[cur] - total[prev]
+		if delta <= 0 {
+			continue
+		}
+
+		busy := delta - (idle[cur] - idle[prev])
+		utilization = append(utilization, metrics.SamplePair{
+			Timestamp: cur,
+			Value:     metrics.SampleValue(100 * busy / delta),
+		})
+	}
+
+	return utilization
+}
+
+// RunDropletMemoryMetrics retrieves the memory usage of a droplet.
+func RunDropletMemoryMetrics(c *CmdConfig) error {
+	id, err := getDropletIDArg(c.NS, c.Args)
+	if err != nil {
+		return err
+	}
+
+	start, end, err := metricsTimeRange(c)
+	if err != nil {
+		return err
+	}
+
+	resolution, err := c.Doit.GetDuration(c.NS, doctl.ArgMetricsResolution)
+	if err != nil {
+		return err
+	}
+
+	ms := c.Monitoring()
+	queries := map[string]func(string, time.Time, time.Time) (*godo.MetricsResponse, error){
+		"Total":     ms.GetDropletTotalMemory,
+		"Free":      ms.GetDropletFreeMemory,
+		"Cached":    ms.GetDropletCachedMemory,
+		"Available": ms.GetDropletAvailableMemory,
+	}
+	series := make(map[string][]metrics.SamplePair, len(queries))
+	for name, query := range queries {
+		resp, err := query(strconv.Itoa(id), start, end)
+		if err != nil {
+			return err
+		}
+		series[name] = firstMetricSamples(resp)
+	}
+
+	const mib = 1 << 20
+	points := metricPoints(series)
+	for _, p := range points {
+		total, hasTotal := p.Values["Total"]
+		available, hasAvailable := p.Values["Available"]
+		if hasTotal && hasAvailable {
+			p.Values["Used"] = total - available
+		}
+		delete(p.Values, "Available")
+
+		for name, v := range p.Values {
+			p.Values[name] = v / mib
+		}
+	}
+
+	item := &displayers.Metrics{
+		Series: []string{"Total", "Used", "Free", "Cached"},
+		Points: resampleMetricPoints(points, resolution),
+	}
+	return c.Display(item)
+}
+
 // RunDropletCreate creates a droplet.
 func RunDropletCreate(c *CmdConfig) error {
+	generateName, err := c.Doit.GetString(c.NS, doctl.ArgDropletGenerateName)
+	if err != nil {
+		return err
+	}
 
-	if len(c.Args) < 1 {
+	names := c.Args
+	if generateName != "" {
+		names = []string{generateDropletName(generateName)}
+	} else if len(names) < 1 {
 		return doctl.NewMissingArgsErr(c.NS)
 	}
 
@@ -216,12 +457,28 @@ func RunDropletCreate(c *CmdConfig) error {
 	if err != nil {
 		return err
 	}
+	if privateNetworking {
+		warn("The `--%s` flag is deprecated and has no effect. All new Droplets are created in a VPC network: the default VPC for the region, or the VPC specified with `--%s`.", doctl.ArgPrivateNetworking, doctl.ArgVPCUUID)
+	}
 
 	monitoring, err := c.Doit.GetBool(c.NS, doctl.ArgMonitoring)
 	if err != nil {
 		return err
 	}
 
+	var regionFeatures []string
+	if ipv6 {
+		regionFeatures = append(regionFeatures, "ipv6")
+	}
+	if monitoring {
+		regionFeatures = append(regionFeatures, "monitoring")
+	}
+	if len(regionFeatures) > 0 {
+		if err := warnMissingRegionFeatures(c.Regions(), region, regionFeatures...); err != nil {
+			return err
+		}
+	}
+
 	agent, err := c.Doit.GetBoolPtr(c.NS, doctl.ArgDropletAgent)
 	if err != nil {
 		return err
@@ -242,6 +499,12 @@ func RunDropletCreate(c *CmdConfig) error {
 		return err
 	}
 
+	if vpcUUID != "" && region != "" {
+		if err := validateDropletCreateVPC(c.VPCs(), region, vpcUUID); err != nil {
+			return err
+		}
+	}
+
 	projectUUID, err := c.Doit.GetString(c.NS, doctl.ArgProjectID)
 	if err != nil {
 		return err
@@ -262,6 +525,12 @@ func RunDropletCreate(c *CmdConfig) error {
 		tagNames = append(tagNames, tagName)
 	}
 
+	tags, err := c.Doit.GetStringSlice(c.NS, doctl.ArgTag)
+	if err != nil {
+		return err
+	}
+	tagNames = dedupeStrings(append(tagNames, tags...))
+
 	sshKeys := extractSSHKeys(keys)
 
 	userData, err := c.Doit.GetString(c.NS, doctl.ArgUserData)
@@ -275,6 +544,20 @@ func RunDropletCreate(c *CmdConfig) error {
 	}
 	volumes := extractVolumes(volumeList)
 
+	volumeIDs, err := c.Doit.GetStringSlice(c.NS, doctl.ArgVolumeID)
+	if err != nil {
+		return err
+	}
+
+	if len(volumeIDs) > 0 {
+		attach, volumeRegion, err := validateDropletCreateVolumes(c.Volumes(), region, volumeIDs)
+		if err != nil {
+			return err
+		}
+		volumes = append(volumes, attach...)
+		region = volumeRegion
+	}
+
 	filename, err := c.Doit.GetString(c.NS, doctl.ArgUserDataFile)
 	if err != nil {
 		return err
@@ -285,6 +568,16 @@ func RunDropletCreate(c *CmdConfig) error {
 		return err
 	}
 
+	packages, err := c.Doit.GetStringSlice(c.NS, doctl.ArgCloudInitAddPackage)
+	if err != nil {
+		return err
+	}
+
+	userData, err = addCloudInitPackages(userData, packages)
+	if err != nil {
+		return err
+	}
+
 	imageStr, err := c.Doit.GetString(c.NS, doctl.ArgImage)
 	if err != nil {
 		return err
@@ -302,27 +595,53 @@ func RunDropletCreate(c *CmdConfig) error {
 		return err
 	}
 
+	waitForHTTP, err := c.Doit.GetString(c.NS, doctl.ArgWaitForHTTP)
+	if err != nil {
+		return err
+	}
+	var httpURL *template.Template
+	if waitForHTTP != "" {
+		httpURL, err = template.New("wait-for-http").Parse(waitForHTTP)
+		if err != nil {
+			return fmt.Errorf("invalid --%s URL: %w", doctl.ArgWaitForHTTP, err)
+		}
+		wait = true
+	}
+	httpTimeout, err := c.Doit.GetDuration(c.NS, doctl.ArgWaitForHTTPTimeout)
+	if err != nil {
+		return err
+	}
+
+	withURLs, err := c.Doit.GetBool(c.NS, doctl.ArgDropletWithURLs)
+	if err != nil {
+		return err
+	}
+
+	tfImport, err := c.Doit.GetBool(c.NS, doctl.ArgTerraformImport)
+	if err != nil {
+		return err
+	}
+
 	ds := c.Droplets()
 
 	var wg sync.WaitGroup
 	var createdList do.Droplets
-	errs := make(chan error, len(c.Args))
-	for _, name := range c.Args {
+	errs := make(chan error, len(names))
+	for _, name := range names {
 		dcr := &godo.DropletCreateRequest{
-			Name:              name,
-			Region:            region,
-			Size:              size,
-			Image:             createImage,
-			Volumes:           volumes,
-			Backups:           backups,
-			BackupPolicy:      backupPolicy,
-			IPv6:              ipv6,
-			PrivateNetworking: privateNetworking,
-			Monitoring:        monitoring,
-			SSHKeys:           sshKeys,
-			UserData:          userData,
-			VPCUUID:           vpcUUID,
-			Tags:              tagNames,
+			Name:         name,
+			Region:       region,
+			Size:         size,
+			Image:        createImage,
+			Volumes:      volumes,
+			Backups:      backups,
+			BackupPolicy: backupPolicy,
+			IPv6:         ipv6,
+			Monitoring:   monitoring,
+			SSHKeys:      sshKeys,
+			UserData:     userData,
+			VPCUUID:      vpcUUID,
+			Tags:         tagNames,
 		}
 
 		if agent != nil {
@@ -346,7 +665,7 @@ func RunDropletCreate(c *CmdConfig) error {
 	wg.Wait()
 	close(errs)
 
-	item := &displayers.Droplet{Droplets: createdList}
+	item := &displayers.Droplet{Droplets: createdList, ShowConsoleURL: withURLs}
 
 	for err := range errs {
 		if err != nil {
@@ -360,9 +679,73 @@ func RunDropletCreate(c *CmdConfig) error {
 		}
 	}
 
+	if httpURL != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), httpTimeout)
+		defer cancel()
+		for _, createdDroplet := range createdList {
+			var u strings.Builder
+			if err := httpURL.Execute(&u, createdDroplet); err != nil {
+				return err
+			}
+			if err := pollHTTP(ctx, u.String()); err != nil {
+				return fmt.Errorf("Droplet %s was created, but %w", createdDroplet.Name, err)
+			}
+		}
+	}
+
+	if tfImport {
+		for _, createdDroplet := range createdList {
+			printTerraformImport("digitalocean_droplet", createdDroplet.Name, strconv.Itoa(createdDroplet.ID))
+		}
+	}
+
 	return c.Display(item)
 }
 
+const generatedNameAlphabet = "abcdefghijklmnopqrstuvwxyz0123456789"
+
+// generateDropletName returns prefix followed by a dash and a random
+// five-character suffix, such as web-k7x2m.
+func generateDropletName(prefix string) string {
+	suffix := make([]byte, 5)
+	for i := range suffix {
+		suffix[i] = generatedNameAlphabet[rand.IntN(len(generatedNameAlphabet))]
+	}
+	return prefix + "-" + string(suffix)
+}
+
+// waitForHTTPInterval is the delay before the first retry of --wait-for-http.
+// It doubles after every attempt, up to waitForHTTPMaxInterval.
+var waitForHTTPInterval = time.Second
+
+const waitForHTTPMaxInterval = 30 * time.Second
+
+// pollHTTP sends GET requests to url with exponential backoff until it
+// responds with a 2xx status or ctx is done.
+func pollHTTP(ctx context.Context, url string) error {
+	interval := waitForHTTPInterval
+	for {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return err
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err == nil {
+			resp.Body.Close()
+			if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+				return nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%s did not respond with a 2xx status in time", url)
+		case <-time.After(interval):
+		}
+		interval = min(interval*2, waitForHTTPMaxInterval)
+	}
+}
+
 // ValidateProjectUUID checks if the given projectUUID exists
 func ValidateProjectUUID(c *CmdConfig, projectUUID string) error {
 	if _, err := uuid.Parse(projectUUID); err != nil {
@@ -475,6 +858,59 @@ func extractUserData(userData, filename string) (string, error) {
 	return userData, nil
 }
 
+const cloudConfigHeader = "#cloud-config"
+
+// addCloudInitPackages adds packages to the `packages` list of a cloud-config
+// document. If userData is empty, a new document is generated.
+func addCloudInitPackages(userData string, packages []string) (string, error) {
+	if len(packages) == 0 {
+		return userData, nil
+	}
+
+	doc := map[string]any{}
+	if strings.TrimSpace(userData) != "" {
+		if !strings.HasPrefix(strings.TrimSpace(userData), cloudConfigHeader) {
+			return "", fmt.Errorf("--%s can only be combined with user data that is a cloud-config document starting with %q", doctl.ArgCloudInitAddPackage, cloudConfigHeader)
+		}
+		if err := yaml.Unmarshal([]byte(userData), &doc); err != nil {
+			return "", fmt.Errorf("parsing cloud-config user data: %w", err)
+		}
+		if doc == nil {
+			doc = map[string]any{}
+		}
+	}
+
+	var existing []any
+	if p, ok := doc["packages"]; ok && p != nil {
+		existing, ok = p.([]any)
+		if !ok {
+			return "", errors.New("the packages key of the cloud-config user data must be a list")
+		}
+	}
+
+	seen := map[string]bool{}
+	for _, p := range existing {
+		if name, ok := p.(string); ok {
+			seen[name] = true
+		}
+	}
+	for _, p := range packages {
+		if seen[p] {
+			continue
+		}
+		seen[p] = true
+		existing = append(existing, p)
+	}
+	doc["packages"] = existing
+
+	out, err := yaml.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+
+	return cloudConfigHeader + "\n" + string(out), nil
+}
+
 func extractVolumes(volumeList []string) []godo.DropletCreateVolume {
 	var volumes []godo.DropletCreateVolume
 
@@ -491,6 +927,51 @@ func extractVolumes(volumeList []string) []godo.DropletCreateVolume {
 	return volumes
 }
 
+// validateDropletCreateVolumes checks that each of the given volumes exists
+// and that they are all located in the region the Droplet is created in. If
+// no region is given, the volumes' region is used. The region is returned so
+// that the Droplet is created next to its volumes.
+func validateDropletCreateVolumes(vs do.VolumesService, region string, volumeIDs []string) ([]godo.DropletCreateVolume, string, error) {
+	volumes := make([]godo.DropletCreateVolume, 0, len(volumeIDs))
+
+	for _, id := range volumeIDs {
+		v, err := vs.Get(id)
+		if err != nil {
+			return nil, "", fmt.Errorf("unable to find volume %q: %w", id, err)
+		}
+
+		volumeRegion := ""
+		if v.Region != nil {
+			volumeRegion = v.Region.Slug
+		}
+		if region == "" {
+			region = volumeRegion
+		}
+		if volumeRegion != region {
+			return nil, "", fmt.Errorf("volume %q is in region %q, but the Droplet is being created in region %q", id, volumeRegion, region)
+		}
+
+		volumes = append(volumes, godo.DropletCreateVolume{ID: id})
+	}
+
+	return volumes, region, nil
+}
+
+// validateDropletCreateVPC checks that the VPC exists and is in the region the
+// Droplet is being created in.
+func validateDropletCreateVPC(vs do.VPCsService, region, vpcUUID string) error {
+	vpc, err := vs.Get(vpcUUID)
+	if err != nil {
+		return fmt.Errorf("unable to find VPC %q: %w", vpcUUID, err)
+	}
+
+	if vpc.RegionSlug != region {
+		return fmt.Errorf("VPC %q is in region %q, but the Droplet is being created in region %q. Use `doctl vpcs list --region %s` to find a VPC in that region", vpcUUID, vpc.RegionSlug, region, region)
+	}
+
+	return nil
+}
+
 func allInt(in []string) ([]int, error) {
 	out := make([]int, 0, len(in))
 	seen := map[string]bool{}
@@ -627,29 +1108,71 @@ func RunDropletGet(c *CmdConfig) error {
 	}
 
 	ds := c.Droplets()
-	fn := func(ids []int) error {
-		for _, id := range ids {
-			d, err := ds.Get(id)
+	droplets, err := getDropletsByIDOrName(ds, c.Args[0])
+	if err != nil {
+		return err
+	}
+
+	if getTemplate != "" {
+		t, err := template.New("Get template").Parse(getTemplate)
+		if err != nil {
+			return err
+		}
+		for _, d := range droplets {
+			if err := t.Execute(c.Out, d); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	item := &displayers.Droplet{Droplets: droplets}
+	if wantsColumn(c, "BackupPolicy") {
+		item.BackupPolicies = map[int]do.DropletBackupPolicy{}
+		for _, d := range droplets {
+			if !slices.Contains(d.Features, "backups") {
+				continue
+			}
+			policy, err := ds.GetBackupPolicy(d.ID)
 			if err != nil {
 				return err
 			}
+			item.BackupPolicies[d.ID] = *policy
+		}
+	}
+	return c.Display(item)
+}
 
-			item := &displayers.Droplet{Droplets: do.Droplets{*d}}
+// getDropletsByIDOrName retrieves the Droplet with the given ID or, if the
+// argument is not numeric, every Droplet with that name.
+func getDropletsByIDOrName(ds do.DropletsService, idOrName string) (do.Droplets, error) {
+	if id, err := strconv.Atoi(idOrName); err == nil {
+		d, err := ds.Get(id)
+		if err != nil {
+			return nil, err
+		}
+		return do.Droplets{*d}, nil
+	}
 
-			if getTemplate != "" {
-				t := template.New("Get template")
-				t, err = t.Parse(getTemplate)
-				if err != nil {
-					return err
-				}
-				return t.Execute(c.Out, d)
-			}
-			return c.Display(item)
+	list, err := ds.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var matched do.Droplets
+	for _, d := range list {
+		if d.Name == idOrName {
+			matched = append(matched, d)
 		}
-		return nil
 	}
-	return matchDroplets(c.Args, ds, fn)
 
+	if len(matched) == 0 {
+		return nil, fmt.Errorf("Droplet with the name %q could not be found.", idOrName)
+	}
+	if len(matched) > 1 && Output == "text" {
+		notice("%d Droplets share the name %q; showing all of them", len(matched), idOrName)
+	}
+	return matched, nil
 }
 
 // RunDropletKernels returns a list of available kernels for a droplet.
@@ -670,6 +1193,34 @@ func RunDropletKernels(c *CmdConfig) error {
 	return c.Display(item)
 }
 
+// dropletCreatedAt parses the creation time of a Droplet. Unparsable values
+// sort first.
+func dropletCreatedAt(d do.Droplet) time.Time {
+	t, _ := time.Parse(time.RFC3339, d.Created)
+	return t
+}
+
+// dropletSortFields lists the values accepted by droplet list --sort-by.
+var dropletSortFields = []string{"name", "status", "region", "size", "memory", "vcpus", "created"}
+
+// dropletSortKeys maps each --sort-by value to its ordering.
+var dropletSortKeys = map[string]func(a, b do.Droplet) bool{
+	"name":    func(a, b do.Droplet) bool { return a.Name < b.Name },
+	"status":  func(a, b do.Droplet) bool { return a.Status < b.Status },
+	"region":  func(a, b do.Droplet) bool { return dropletRegionSlug(a) < dropletRegionSlug(b) },
+	"size":    func(a, b do.Droplet) bool { return a.SizeSlug < b.SizeSlug },
+	"memory":  func(a, b do.Droplet) bool { return a.Memory < b.Memory },
+	"vcpus":   func(a, b do.Droplet) bool { return a.Vcpus < b.Vcpus },
+	"created": func(a, b do.Droplet) bool { return dropletCreatedAt(a).Before(dropletCreatedAt(b)) },
+}
+
+func dropletRegionSlug(d do.Droplet) string {
+	if d.Region == nil {
+		return ""
+	}
+	return d.Region.Slug
+}
+
 // RunDropletList returns a list of droplets.
 func RunDropletList(c *CmdConfig) error {
 
@@ -690,6 +1241,25 @@ func RunDropletList(c *CmdConfig) error {
 		return err
 	}
 
+	resolveNames, err := c.Doit.GetBool(c.NS, doctl.ArgResolveNames)
+	if err != nil {
+		return err
+	}
+
+	withAgentInfo, err := c.Doit.GetBool(c.NS, doctl.ArgDropletWithAgentInfo)
+	if err != nil {
+		return err
+	}
+
+	sortBy, err := c.Doit.GetString(c.NS, doctl.ArgSortBy)
+	if err != nil {
+		return err
+	}
+	less, ok := dropletSortKeys[sortBy]
+	if sortBy != "" && !ok {
+		return fmt.Errorf("invalid value %q for --%s; possible values: %s", sortBy, doctl.ArgSortBy, strings.Join(dropletSortFields, ", "))
+	}
+
 	if gpus && tagName != "" {
 		return fmt.Errorf("The --gpus and --tag-name flags are mutually exclusive.")
 	}
@@ -741,10 +1311,259 @@ func RunDropletList(c *CmdConfig) error {
 		}
 	}
 
+	if less != nil {
+		sort.SliceStable(matchedList, func(i, j int) bool {
+			return less(matchedList[i], matchedList[j])
+		})
+	}
+
+	format, err := c.Doit.GetString(c.NS, doctl.ArgFormat)
+	if err != nil {
+		return err
+	}
+	if strings.EqualFold(format, "ssh-config") {
+		return writeSSHConfig(c.Out, matchedList)
+	}
+
 	item := &displayers.Droplet{Droplets: matchedList}
+	if !wantsColumn(c, "Tags") {
+		item.MaxTags = dropletListMaxTags
+	}
+	if resolveNames {
+		item.VolumeNames, err = resolveVolumeNames(c.Volumes(), matchedList)
+		if err != nil {
+			return err
+		}
+	}
+
+	if withAgentInfo {
+		item.MetricsAgents = resolveMetricsAgents(c.Monitoring(), matchedList)
+	}
+
+	if wantsColumn(c, "BackupPolicy") {
+		policies, err := ds.ListBackupPolicies()
+		if err != nil {
+			return err
+		}
+		item.BackupPolicies = make(map[int]do.DropletBackupPolicy, len(policies))
+		for _, p := range policies {
+			item.BackupPolicies[p.DropletID] = p
+		}
+	}
+
 	return c.Display(item)
 }
 
+// wantsColumn reports whether the column was explicitly requested with the
+// --format flag.
+func wantsColumn(c *CmdConfig, col string) bool {
+	format, err := c.Doit.GetString(c.NS, doctl.ArgFormat)
+	if err != nil {
+		return false
+	}
+	for _, f := range strings.Split(format, ",") {
+		if strings.EqualFold(strings.TrimSpace(f), col) {
+			return true
+		}
+	}
+	return false
+}
+
+// dedupeStrings returns the non-empty strings in s with duplicates removed,
+// preserving their original order.
+func dedupeStrings(s []string) []string {
+	if s == nil {
+		return nil
+	}
+
+	seen := make(map[string]bool, len(s))
+	out := make([]string, 0, len(s))
+	for _, v := range s {
+		if v == "" || seen[v] {
+			continue
+		}
+		seen[v] = true
+		out = append(out, v)
+	}
+
+	return out
+}
+
+// resolveVolumeNames concurrently looks up the names of all volumes attached
+// to the given Droplets and returns them keyed by volume ID.
+func resolveVolumeNames(vs do.VolumesService, droplets do.Droplets) (map[string]string, error) {
+	ids := make(map[string]struct{})
+	for _, d := range droplets {
+		for _, id := range d.VolumeIDs {
+			ids[id] = struct{}{}
+		}
+	}
+
+	names := make(map[string]string, len(ids))
+	var mu sync.Mutex
+	var grp errgroup.Group
+	grp.SetLimit(resolveNamesConcurrency)
+	for id := range ids {
+		grp.Go(func() error {
+			v, err := vs.Get(id)
+			if err != nil {
+				return err
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			names[id] = v.Name
+			return nil
+		})
+	}
+
+	if err := grp.Wait(); err != nil {
+		return nil, err
+	}
+
+	return names, nil
+}
+
+// warnMissingRegionFeatures prints a warning for each feature that is not
+// available in the region. Nothing is checked when no region is given.
+func warnMissingRegionFeatures(rs do.RegionsService, regionSlug string, features ...string) error {
+	if regionSlug == "" {
+		return nil
+	}
+
+	regions, err := rs.List()
+	if err != nil {
+		return err
+	}
+
+	for _, r := range regions {
+		if r.Slug != regionSlug {
+			continue
+		}
+		for _, f := range features {
+			if !slices.Contains(r.Features, f) {
+				if alt := regionWithFeature(regions, f); alt != "" {
+					warn("The %s region does not support the %s feature. The %s region supports it.", regionSlug, f, alt)
+				} else {
+					warn("The %s region does not support the %s feature.", regionSlug, f)
+				}
+			}
+		}
+		break
+	}
+
+	return nil
+}
+
+// regionWithFeature returns the slug of the first available region that
+// supports the given feature, or an empty string if there is none.
+func regionWithFeature(regions do.Regions, feature string) string {
+	for _, r := range regions {
+		if r.Available && slices.Contains(r.Features, feature) {
+			return r.Slug
+		}
+	}
+	return ""
+}
+
+// dropletListMaxTags is the number of tags shown for each Droplet in the
+// default list output.
+const dropletListMaxTags = 3
+
+// resolveNamesConcurrency limits the number of concurrent requests made when
+// resolving resource names.
+const resolveNamesConcurrency = 5
+
+// resolveDropletNames returns a map of Droplet IDs to Droplet names. Each
+// Droplet is only fetched once, even if its ID is repeated.
+func resolveDropletNames(ds do.DropletsService, dropletIDs []int) (map[int]string, error) {
+	ids := make(map[int]struct{})
+	for _, id := range dropletIDs {
+		ids[id] = struct{}{}
+	}
+
+	names := make(map[int]string, len(ids))
+	var mu sync.Mutex
+	var grp errgroup.Group
+	grp.SetLimit(resolveNamesConcurrency)
+	for id := range ids {
+		grp.Go(func() error {
+			d, err := ds.Get(id)
+			if err != nil {
+				return err
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			names[id] = d.Name
+			return nil
+		})
+	}
+
+	if err := grp.Wait(); err != nil {
+		return nil, err
+	}
+
+	return names, nil
+}
+
+// metricsAgentWindow is how recently the metrics agent must have sent metrics
+// for it to be considered running.
+const metricsAgentWindow = 10 * time.Minute
+
+// resolveMetricsAgents returns a map of Droplet IDs to whether the metrics
+// agent (do-agent) is running on them. Memory metrics are only collected by
+// that agent, so a Droplet is considered to run it if it recently reported any.
+// Droplets whose metrics can't be retrieved, such as Droplets that are still
+// being provisioned, are left out of the map rather than failing the list.
+func resolveMetricsAgents(ms do.MonitoringService, droplets do.Droplets) map[int]bool {
+	end := time.Now()
+	start := end.Add(-metricsAgentWindow)
+
+	agents := make(map[int]bool, len(droplets))
+	var mu sync.Mutex
+	var grp errgroup.Group
+	grp.SetLimit(resolveNamesConcurrency)
+	for _, d := range droplets {
+		grp.Go(func() error {
+			resp, err := ms.GetDropletTotalMemory(strconv.Itoa(d.ID), start, end)
+			if err != nil {
+				return nil
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			agents[d.ID] = len(firstMetricSamples(resp)) > 0
+			return nil
+		})
+	}
+
+	grp.Wait()
+
+	return agents
+}
+
+// writeSSHConfig writes an ssh_config(5) Host entry for each droplet with a
+// public IPv4 address.
+func writeSSHConfig(out io.Writer, droplets do.Droplets) error {
+	var written bool
+	for _, d := range droplets {
+		ip, err := d.PublicIPv4()
+		if err != nil || ip == "" {
+			warn("Skipping Droplet %s: it has no public IPv4 address", d.Name)
+			continue
+		}
+
+		if written {
+			fmt.Fprintln(out)
+		}
+		written = true
+		fmt.Fprintf(out, "Host %s\n  HostName %s\n  User root\n", d.Name, ip)
+	}
+
+	return nil
+}
+
 // RunDropletNeighbors returns a list of droplet neighbors.
 func RunDropletNeighbors(c *CmdConfig) error {
 
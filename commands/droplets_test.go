@@ -15,14 +15,22 @@ package commands
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"strconv"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/do"
 	"github.com/digitalocean/godo"
+	"github.com/digitalocean/godo/metrics"
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
 )
 
 var (
@@ -53,7 +61,7 @@ var (
 func TestDropletCommand(t *testing.T) {
 	cmd := Droplet()
 	assert.NotNil(t, cmd)
-	assertCommandNames(t, cmd, "1-click", "actions", "backups", "backup-policies", "create", "delete", "get", "kernels", "list", "neighbors", "snapshots", "tag", "untag")
+	assertCommandNames(t, cmd, "1-click", "actions", "backups", "backup-policies", "bandwidth", "cpu", "create", "delete", "get", "kernels", "list", "memory", "neighbors", "snapshots", "tag", "untag")
 }
 
 func TestDropletActionList(t *testing.T) {
@@ -78,6 +86,122 @@ func TestDropletBackupList(t *testing.T) {
 	})
 }
 
+func TestDropletBandwidthMetrics(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		end := start.Add(2 * time.Minute)
+		series := func(values ...metrics.SampleValue) *godo.MetricsResponse {
+			samples := make([]metrics.SamplePair, len(values))
+			for i, v := range values {
+				samples[i] = metrics.SamplePair{Timestamp: metrics.TimeFromUnix(start.Add(time.Duration(i) * time.Minute).Unix()), Value: v}
+			}
+			return &godo.MetricsResponse{Data: godo.MetricsData{Result: []metrics.SampleStream{{Values: samples}}}}
+		}
+
+		tm.monitoring.EXPECT().GetDropletBandwidth("1", "private", "inbound", start, end).Return(series(1.5, 2), nil)
+		tm.monitoring.EXPECT().GetDropletBandwidth("1", "private", "outbound", start, end).Return(series(0.25, 4), nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Args = append(config.Args, "1")
+		config.Doit.Set(config.NS, doctl.ArgMetricsInterface, "private")
+		config.Doit.Set(config.NS, doctl.ArgMetricsStart, start.Format(time.RFC3339))
+		config.Doit.Set(config.NS, doctl.ArgMetricsEnd, end.Format(time.RFC3339))
+
+		var err error
+		warnings := captureStderr(t, func() { err = RunDropletBandwidthMetrics(config) })
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), "2026-01-01T00:00:00Z    1.50       0.25")
+		assert.Contains(t, buf.String(), "2026-01-01T00:01:00Z    2.00       4.00")
+		assert.Contains(t, warnings, "95th percentile: 2.00 Mbps inbound, 4.00 Mbps outbound")
+	})
+}
+
+func TestDropletBandwidthMetricsInvalidRange(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Args = append(config.Args, "1")
+		config.Doit.Set(config.NS, doctl.ArgMetricsInterface, "public")
+		config.Doit.Set(config.NS, doctl.ArgMetricsStart, "2026-01-02T00:00:00Z")
+		config.Doit.Set(config.NS, doctl.ArgMetricsEnd, "2026-01-01T00:00:00Z")
+
+		err := RunDropletBandwidthMetrics(config)
+		assert.EqualError(t, err, "--start must be before --end")
+	})
+}
+
+func TestDropletCPUMetrics(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		end := start.Add(10 * time.Minute)
+		at := func(minutes int) metrics.Time {
+			return metrics.TimeFromUnix(start.Add(time.Duration(minutes) * time.Minute).Unix())
+		}
+
+		// Between the samples, the Droplet spent 25% and 75% of its CPU
+		// time outside the idle mode.
+		resp := &godo.MetricsResponse{
+			Data: godo.MetricsData{Result: []metrics.SampleStream{
+				{
+					Metric: metrics.Metric{"mode": "idle"},
+					Values: []metrics.SamplePair{{Timestamp: at(0), Value: 100}, {Timestamp: at(1), Value: 175}, {Timestamp: at(5), Value: 200}},
+				},
+				{
+					Metric: metrics.Metric{"mode": "user"},
+					Values: []metrics.SamplePair{{Timestamp: at(0), Value: 10}, {Timestamp: at(1), Value: 35}, {Timestamp: at(5), Value: 110}},
+				},
+			}},
+		}
+		tm.monitoring.EXPECT().GetDropletCPU("1", start, end).Return(resp, nil).Times(2)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Args = append(config.Args, "1")
+		config.Doit.Set(config.NS, doctl.ArgMetricsStart, start.Format(time.RFC3339))
+		config.Doit.Set(config.NS, doctl.ArgMetricsEnd, end.Format(time.RFC3339))
+
+		err := RunDropletCPUMetrics(config)
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), "2026-01-01T00:01:00Z    25.00")
+		assert.Contains(t, buf.String(), "2026-01-01T00:05:00Z    75.00")
+
+		buf.Reset()
+		config.Doit.Set(config.NS, doctl.ArgMetricsResolution, 10*time.Minute)
+
+		err = RunDropletCPUMetrics(config)
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), "2026-01-01T00:00:00Z    50.00")
+		assert.NotContains(t, buf.String(), "2026-01-01T00:05:00Z")
+	})
+}
+
+func TestDropletMemoryMetrics(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		end := start.Add(time.Hour)
+		mib := func(v metrics.SampleValue) *godo.MetricsResponse {
+			return &godo.MetricsResponse{Data: godo.MetricsData{Result: []metrics.SampleStream{{
+				Values: []metrics.SamplePair{{Timestamp: metrics.TimeFromUnix(start.Unix()), Value: v * (1 << 20)}},
+			}}}}
+		}
+
+		tm.monitoring.EXPECT().GetDropletTotalMemory("1", start, end).Return(mib(1024), nil)
+		tm.monitoring.EXPECT().GetDropletFreeMemory("1", start, end).Return(mib(256), nil)
+		tm.monitoring.EXPECT().GetDropletCachedMemory("1", start, end).Return(mib(128), nil)
+		tm.monitoring.EXPECT().GetDropletAvailableMemory("1", start, end).Return(mib(384), nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Args = append(config.Args, "1")
+		config.Doit.Set(config.NS, doctl.ArgMetricsStart, start.Format(time.RFC3339))
+		config.Doit.Set(config.NS, doctl.ArgMetricsEnd, end.Format(time.RFC3339))
+
+		err := RunDropletMemoryMetrics(config)
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), "Time                    Total      Used      Free      Cached")
+		assert.Contains(t, buf.String(), "2026-01-01T00:00:00Z    1024.00    640.00    256.00    128.00")
+	})
+}
+
 func TestDropletCreate(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		volumeUUID := "00000000-0000-4000-8000-000000000000"
@@ -100,6 +224,7 @@ func TestDropletCreate(t *testing.T) {
 			UserData:          "#cloud-config",
 			Tags:              []string{"one", "two"},
 		}
+		tm.vpcs.EXPECT().Get(vpcUUID).Return(&do.VPC{VPC: &godo.VPC{ID: vpcUUID, RegionSlug: "dev0"}}, nil)
 		tm.droplets.EXPECT().Create(dcr, false).Return(&testDroplet, nil)
 
 		config.Args = append(config.Args, "droplet")
@@ -117,6 +242,182 @@ func TestDropletCreate(t *testing.T) {
 	})
 }
 
+func TestDropletCreateVPCRegionMismatch(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		vpcUUID := "00000000-0000-4000-8000-000000000000"
+		tm.vpcs.EXPECT().Get(vpcUUID).Return(&do.VPC{VPC: &godo.VPC{ID: vpcUUID, RegionSlug: "nyc1"}}, nil)
+
+		config.Args = append(config.Args, "droplet")
+
+		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "sfo3")
+		config.Doit.Set(config.NS, doctl.ArgSizeSlug, "1gb")
+		config.Doit.Set(config.NS, doctl.ArgImage, "image")
+		config.Doit.Set(config.NS, doctl.ArgVPCUUID, vpcUUID)
+
+		err := RunDropletCreate(config)
+		assert.EqualError(t, err, `VPC "00000000-0000-4000-8000-000000000000" is in region "nyc1", but the Droplet is being created in region "sfo3". Use `+"`doctl vpcs list --region sfo3`"+` to find a VPC in that region`)
+	})
+}
+
+func TestDropletCreateWithDeprecatedPrivateNetworking(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		dcr := &godo.DropletCreateRequest{
+			Name:    "droplet",
+			Region:  "dev0",
+			Size:    "1gb",
+			Image:   godo.DropletCreateImage{ID: 0, Slug: "image"},
+			SSHKeys: []godo.DropletCreateSSHKey{},
+			Tags:    []string{},
+		}
+		tm.droplets.EXPECT().Create(dcr, false).Return(&testDroplet, nil)
+
+		config.Args = append(config.Args, "droplet")
+
+		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "dev0")
+		config.Doit.Set(config.NS, doctl.ArgSizeSlug, "1gb")
+		config.Doit.Set(config.NS, doctl.ArgImage, "image")
+		config.Doit.Set(config.NS, doctl.ArgPrivateNetworking, true)
+		config.Doit.Set(config.NS, doctl.ArgTagNames, []string{})
+
+		err := RunDropletCreate(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestDropletCreateWithURLs(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		dcr := &godo.DropletCreateRequest{
+			Name:    "droplet",
+			Region:  "dev0",
+			Size:    "1gb",
+			Image:   godo.DropletCreateImage{ID: 0, Slug: "image"},
+			SSHKeys: []godo.DropletCreateSSHKey{},
+			Tags:    []string{},
+		}
+		tm.droplets.EXPECT().Create(dcr, false).Return(&testDroplet, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Args = append(config.Args, "droplet")
+
+		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "dev0")
+		config.Doit.Set(config.NS, doctl.ArgSizeSlug, "1gb")
+		config.Doit.Set(config.NS, doctl.ArgImage, "image")
+		config.Doit.Set(config.NS, doctl.ArgTagNames, []string{})
+		config.Doit.Set(config.NS, doctl.ArgDropletWithURLs, true)
+
+		err := RunDropletCreate(config)
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), "Console URL")
+		assert.Contains(t, buf.String(), "https://cloud.digitalocean.com/droplets/1")
+	})
+}
+
+func TestDropletCreateWithIPv6(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		dcr := &godo.DropletCreateRequest{
+			Name:    "droplet",
+			Region:  "dev0",
+			Size:    "1gb",
+			Image:   godo.DropletCreateImage{ID: 0, Slug: "image"},
+			SSHKeys: []godo.DropletCreateSSHKey{},
+			IPv6:    true,
+			Tags:    []string{},
+		}
+		regions := do.Regions{
+			{Region: &godo.Region{Slug: "dev0", Features: []string{"backups", "ipv6"}}},
+		}
+		tm.regions.EXPECT().List().Return(regions, nil)
+		tm.droplets.EXPECT().Create(dcr, false).Return(&testDroplet, nil)
+
+		config.Args = append(config.Args, "droplet")
+
+		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "dev0")
+		config.Doit.Set(config.NS, doctl.ArgSizeSlug, "1gb")
+		config.Doit.Set(config.NS, doctl.ArgImage, "image")
+		config.Doit.Set(config.NS, doctl.ArgIPv6, true)
+		config.Doit.Set(config.NS, doctl.ArgTagNames, []string{})
+
+		err := RunDropletCreate(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestDropletCreateWithMonitoringUnsupportedRegion(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		dcr := &godo.DropletCreateRequest{
+			Name:       "droplet",
+			Region:     "dev0",
+			Size:       "1gb",
+			Image:      godo.DropletCreateImage{ID: 0, Slug: "image"},
+			SSHKeys:    []godo.DropletCreateSSHKey{},
+			Monitoring: true,
+			Tags:       []string{},
+		}
+		regions := do.Regions{
+			{Region: &godo.Region{Slug: "dev0", Available: true, Features: []string{"backups"}}},
+			{Region: &godo.Region{Slug: "dev1", Available: true, Features: []string{"backups", "monitoring"}}},
+		}
+		tm.regions.EXPECT().List().Return(regions, nil)
+		tm.droplets.EXPECT().Create(dcr, false).Return(&testDroplet, nil)
+
+		config.Args = append(config.Args, "droplet")
+
+		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "dev0")
+		config.Doit.Set(config.NS, doctl.ArgSizeSlug, "1gb")
+		config.Doit.Set(config.NS, doctl.ArgImage, "image")
+		config.Doit.Set(config.NS, doctl.ArgMonitoring, true)
+		config.Doit.Set(config.NS, doctl.ArgTagNames, []string{})
+
+		var err error
+		warnings := captureStderr(t, func() { err = RunDropletCreate(config) })
+		assert.NoError(t, err)
+		assert.Contains(t, warnings, "The dev0 region does not support the monitoring feature. The dev1 region supports it.")
+	})
+}
+
+func TestDropletCreateWaitForHTTP(t *testing.T) {
+	defer func(interval time.Duration) { waitForHTTPInterval = interval }(waitForHTTPInterval)
+	waitForHTTPInterval = time.Millisecond
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := requests.Add(1)
+		assert.Equal(t, "/a-droplet", r.URL.Path)
+		if n < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		dcr := &godo.DropletCreateRequest{
+			Name:    "droplet",
+			Region:  "dev0",
+			Size:    "1gb",
+			Image:   godo.DropletCreateImage{ID: 0, Slug: "image"},
+			SSHKeys: []godo.DropletCreateSSHKey{},
+			Tags:    []string{},
+		}
+		tm.droplets.EXPECT().Create(dcr, true).Return(&testDroplet, nil)
+
+		config.Args = append(config.Args, "droplet")
+
+		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "dev0")
+		config.Doit.Set(config.NS, doctl.ArgSizeSlug, "1gb")
+		config.Doit.Set(config.NS, doctl.ArgImage, "image")
+		config.Doit.Set(config.NS, doctl.ArgTagNames, []string{})
+		config.Doit.Set(config.NS, doctl.ArgWaitForHTTP, server.URL+"/{{.Name}}")
+		config.Doit.Set(config.NS, doctl.ArgWaitForHTTPTimeout, time.Minute)
+
+		err := RunDropletCreate(config)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(3), requests.Load())
+	})
+}
+
 func TestDropletCreateWithBackupPolicy(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		dropletPolicy := godo.DropletBackupPolicyRequest{
@@ -146,6 +447,7 @@ func TestDropletCreateWithBackupPolicy(t *testing.T) {
 			BackupPolicy:      &dropletPolicy,
 		}
 
+		tm.vpcs.EXPECT().Get(vpcUUID).Return(&do.VPC{VPC: &godo.VPC{ID: vpcUUID, RegionSlug: "dev0"}}, nil)
 		tm.droplets.EXPECT().Create(dcr, false).Return(&testDroplet, nil)
 
 		config.Args = append(config.Args, "droplet")
@@ -195,6 +497,79 @@ func TestDropletCreateWithTag(t *testing.T) {
 	})
 }
 
+func TestDropletCreateGenerateName(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		var created *godo.DropletCreateRequest
+		tm.droplets.EXPECT().Create(gomock.Any(), false).DoAndReturn(func(dcr *godo.DropletCreateRequest, _ bool) (*do.Droplet, error) {
+			created = dcr
+			d := *testDroplet.Droplet
+			d.Name = dcr.Name
+			return &do.Droplet{Droplet: &d}, nil
+		})
+
+		config.Args = append(config.Args, "ignored")
+
+		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "dev0")
+		config.Doit.Set(config.NS, doctl.ArgSizeSlug, "1gb")
+		config.Doit.Set(config.NS, doctl.ArgImage, "image")
+		config.Doit.Set(config.NS, doctl.ArgDropletGenerateName, "web")
+		config.Doit.Set(config.NS, doctl.ArgFormat, "Name")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+
+		err := RunDropletCreate(config)
+		assert.NoError(t, err)
+		assert.Regexp(t, `^web-[a-z0-9]{5}$`, created.Name)
+		assert.Equal(t, created.Name+"\n", buf.String())
+	})
+}
+
+func TestDropletCreateTerraformImport(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.droplets.EXPECT().Create(gomock.Any(), false).Return(&testDroplet, nil)
+
+		config.Args = append(config.Args, testDroplet.Name)
+		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "dev0")
+		config.Doit.Set(config.NS, doctl.ArgSizeSlug, "1gb")
+		config.Doit.Set(config.NS, doctl.ArgImage, "image")
+		config.Doit.Set(config.NS, doctl.ArgTerraformImport, true)
+
+		var err error
+		stderr := captureStderr(t, func() { err = RunDropletCreate(config) })
+		assert.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("terraform import digitalocean_droplet.%s %d\n", testDroplet.Name, testDroplet.ID), stderr)
+	})
+}
+
+func TestDropletCreateWithRepeatedTag(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		dcr := &godo.DropletCreateRequest{
+			Name:              "droplet",
+			Region:            "dev0",
+			Size:              "1gb",
+			Image:             godo.DropletCreateImage{ID: 0, Slug: "image"},
+			SSHKeys:           []godo.DropletCreateSSHKey{},
+			Backups:           false,
+			IPv6:              false,
+			PrivateNetworking: false,
+			Tags:              []string{"web", "prod", "db"}}
+		tm.droplets.EXPECT().Create(dcr, false).Return(&testDroplet, nil)
+
+		config.Args = append(config.Args, "droplet")
+
+		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "dev0")
+		config.Doit.Set(config.NS, doctl.ArgSizeSlug, "1gb")
+		config.Doit.Set(config.NS, doctl.ArgImage, "image")
+		config.Doit.Set(config.NS, doctl.ArgTagNames, []string{"web", "prod"})
+		config.Doit.Set(config.NS, doctl.ArgTag, []string{"prod", "db", "web"})
+
+		err := RunDropletCreate(config)
+		assert.NoError(t, err)
+	})
+}
+
 func TestDropletCreateUserDataFile(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		userData := `
@@ -249,6 +624,144 @@ coreos:
 	})
 }
 
+func TestDropletCreateWithCloudInitPackages(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		dcr := &godo.DropletCreateRequest{
+			Name:     "droplet",
+			Region:   "dev0",
+			Size:     "1gb",
+			Image:    godo.DropletCreateImage{ID: 0, Slug: "image"},
+			SSHKeys:  []godo.DropletCreateSSHKey{},
+			UserData: "#cloud-config\npackages:\n- nginx\n- git\n",
+		}
+		tm.droplets.EXPECT().Create(dcr, false).Return(&testDroplet, nil)
+
+		config.Args = append(config.Args, "droplet")
+
+		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "dev0")
+		config.Doit.Set(config.NS, doctl.ArgSizeSlug, "1gb")
+		config.Doit.Set(config.NS, doctl.ArgImage, "image")
+		config.Doit.Set(config.NS, doctl.ArgCloudInitAddPackage, []string{"nginx", "git"})
+
+		err := RunDropletCreate(config)
+		assert.NoError(t, err)
+	})
+}
+
+func Test_addCloudInitPackages(t *testing.T) {
+	cases := []struct {
+		name     string
+		userData string
+		packages []string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "no packages",
+			userData: "#!/bin/bash\necho hi",
+			expected: "#!/bin/bash\necho hi",
+		},
+		{
+			name:     "no user data",
+			packages: []string{"nginx"},
+			expected: "#cloud-config\npackages:\n- nginx\n",
+		},
+		{
+			name:     "merged into existing document",
+			userData: "#cloud-config\npackage_update: true\npackages:\n  - git\n",
+			packages: []string{"nginx", "git"},
+			expected: "#cloud-config\npackage_update: true\npackages:\n- git\n- nginx\n",
+		},
+		{
+			name:     "shell script",
+			userData: "#!/bin/bash\necho hi",
+			packages: []string{"nginx"},
+			wantErr:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := addCloudInitPackages(tc.userData, tc.packages)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestDropletCreateWithVolumeIDs(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		dcr := &godo.DropletCreateRequest{
+			Name:    "droplet",
+			Region:  "atlantis",
+			Size:    "1gb",
+			Image:   godo.DropletCreateImage{ID: 0, Slug: "image"},
+			SSHKeys: []godo.DropletCreateSSHKey{},
+			Volumes: []godo.DropletCreateVolume{
+				{ID: testVolume.ID},
+			},
+		}
+		tm.volumes.EXPECT().Get(testVolume.ID).Return(&testVolume, nil)
+		tm.droplets.EXPECT().Create(dcr, false).Return(&testDroplet, nil)
+
+		config.Args = append(config.Args, "droplet")
+
+		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "atlantis")
+		config.Doit.Set(config.NS, doctl.ArgSizeSlug, "1gb")
+		config.Doit.Set(config.NS, doctl.ArgImage, "image")
+		config.Doit.Set(config.NS, doctl.ArgVolumeID, []string{testVolume.ID})
+
+		err := RunDropletCreate(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestDropletCreateWithVolumeIDsWithoutRegion(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		dcr := &godo.DropletCreateRequest{
+			Name:    "droplet",
+			Region:  "atlantis",
+			Size:    "1gb",
+			Image:   godo.DropletCreateImage{ID: 0, Slug: "image"},
+			SSHKeys: []godo.DropletCreateSSHKey{},
+			Volumes: []godo.DropletCreateVolume{
+				{ID: testVolume.ID},
+			},
+		}
+		tm.volumes.EXPECT().Get(testVolume.ID).Return(&testVolume, nil)
+		tm.droplets.EXPECT().Create(dcr, false).Return(&testDroplet, nil)
+
+		config.Args = append(config.Args, "droplet")
+
+		config.Doit.Set(config.NS, doctl.ArgSizeSlug, "1gb")
+		config.Doit.Set(config.NS, doctl.ArgImage, "image")
+		config.Doit.Set(config.NS, doctl.ArgVolumeID, []string{testVolume.ID})
+
+		err := RunDropletCreate(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestDropletCreateWithVolumeIDsWrongRegion(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.volumes.EXPECT().Get(testVolume.ID).Return(&testVolume, nil)
+
+		config.Args = append(config.Args, "droplet")
+
+		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "dev0")
+		config.Doit.Set(config.NS, doctl.ArgSizeSlug, "1gb")
+		config.Doit.Set(config.NS, doctl.ArgImage, "image")
+		config.Doit.Set(config.NS, doctl.ArgVolumeID, []string{testVolume.ID})
+
+		err := RunDropletCreate(config)
+		assert.ErrorContains(t, err, `volume "00000000-0000-4000-8000-000000000000" is in region "atlantis"`)
+	})
+}
+
 func TestDropletCreateWithProjectID(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		projectUUID := "00000000-0000-4000-8000-000000000000"
@@ -409,6 +922,68 @@ func TestDropletGet_Template(t *testing.T) {
 	})
 }
 
+func TestDropletGetByName(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.droplets.EXPECT().List().Return(testDropletList, nil)
+
+		config.Args = append(config.Args, testDroplet.Name)
+
+		err := RunDropletGet(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestDropletGetByName_Duplicates(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		other := godo.Droplet{ID: 2, Name: testDroplet.Name, Image: &godo.Image{}, Region: &godo.Region{}}
+		list := do.Droplets{testDroplet, {Droplet: &other}}
+		tm.droplets.EXPECT().List().Return(list, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Args = append(config.Args, testDroplet.Name)
+		config.Doit.Set(config.NS, doctl.ArgFormat, "ID,Name")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := RunDropletGet(config)
+		assert.NoError(t, err)
+		assert.Equal(t, "1    a-droplet\n2    a-droplet\n", buf.String())
+	})
+}
+
+func TestDropletGetByName_UnknownOutput(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		other := godo.Droplet{ID: 2, Name: testDroplet.Name, Image: &godo.Image{}, Region: &godo.Region{}}
+		list := do.Droplets{testDroplet, {Droplet: &other}}
+		tm.droplets.EXPECT().List().Return(list, nil)
+
+		origOutput := Output
+		Output = "yaml"
+		defer func() { Output = origOutput }()
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Args = append(config.Args, testDroplet.Name)
+
+		var err error
+		notices := captureStderr(t, func() { err = RunDropletGet(config) })
+		assert.EqualError(t, err, "unknown output type")
+		assert.Empty(t, buf.String())
+		assert.Empty(t, notices)
+	})
+}
+
+func TestDropletGetByName_NotFound(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.droplets.EXPECT().List().Return(testDropletList, nil)
+
+		config.Args = append(config.Args, "missing-droplet")
+
+		err := RunDropletGet(config)
+		assert.EqualError(t, err, `Droplet with the name "missing-droplet" could not be found.`)
+	})
+}
+
 func TestDropletKernelList(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		tm.droplets.EXPECT().Kernels(testDroplet.ID).Return(testKernelList, nil)
@@ -472,6 +1047,217 @@ func TestDropletsListGPUs(t *testing.T) {
 	})
 }
 
+func TestDropletsListResolveNames(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		droplets := do.Droplets{
+			{Droplet: &godo.Droplet{ID: 1, Name: "one", Image: &godo.Image{}, Region: &godo.Region{}, VolumeIDs: []string{testVolume.ID}}},
+			{Droplet: &godo.Droplet{ID: 2, Name: "two", Image: &godo.Image{}, Region: &godo.Region{}, VolumeIDs: []string{testVolume.ID}}},
+		}
+		tm.droplets.EXPECT().List().Return(droplets, nil)
+		tm.volumes.EXPECT().Get(testVolume.ID).Return(&testVolume, nil).Times(1)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Doit.Set(config.NS, doctl.ArgResolveNames, true)
+		config.Doit.Set(config.NS, doctl.ArgFormat, "ID,Volumes")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := RunDropletList(config)
+		assert.NoError(t, err)
+		assert.Equal(t, "1    test-volume(00000000-0000-4000-8000-000000000000)\n2    test-volume(00000000-0000-4000-8000-000000000000)\n", buf.String())
+	})
+}
+
+func TestDropletsListWithAgentInfo(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		droplets := do.Droplets{
+			{Droplet: &godo.Droplet{ID: 1, Image: &godo.Image{}, Region: &godo.Region{}}},
+			{Droplet: &godo.Droplet{ID: 2, Image: &godo.Image{}, Region: &godo.Region{}}},
+		}
+		tm.droplets.EXPECT().List().Return(droplets, nil)
+		reporting := &godo.MetricsResponse{}
+		reporting.Data.Result = []metrics.SampleStream{{Values: []metrics.SamplePair{{Timestamp: metrics.TimeFromUnix(time.Now().Unix()), Value: 1024}}}}
+		tm.monitoring.EXPECT().GetDropletTotalMemory("1", gomock.Any(), gomock.Any()).Return(reporting, nil)
+		tm.monitoring.EXPECT().GetDropletTotalMemory("2", gomock.Any(), gomock.Any()).Return(&godo.MetricsResponse{}, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Doit.Set(config.NS, doctl.ArgDropletWithAgentInfo, true)
+		config.Doit.Set(config.NS, doctl.ArgFormat, "ID,MetricsAgent")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := RunDropletList(config)
+		assert.NoError(t, err)
+		assert.Equal(t, "1    reporting\n2    N/A\n", buf.String())
+	})
+}
+
+func TestDropletsListWithAgentInfoMetricsError(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		droplets := do.Droplets{
+			{Droplet: &godo.Droplet{ID: 1, Image: &godo.Image{}, Region: &godo.Region{}}},
+			{Droplet: &godo.Droplet{ID: 2, Image: &godo.Image{}, Region: &godo.Region{}}},
+		}
+		tm.droplets.EXPECT().List().Return(droplets, nil)
+		reporting := &godo.MetricsResponse{}
+		reporting.Data.Result = []metrics.SampleStream{{Values: []metrics.SamplePair{{Timestamp: metrics.TimeFromUnix(time.Now().Unix()), Value: 1024}}}}
+		tm.monitoring.EXPECT().GetDropletTotalMemory("1", gomock.Any(), gomock.Any()).Return(reporting, nil)
+		tm.monitoring.EXPECT().GetDropletTotalMemory("2", gomock.Any(), gomock.Any()).Return(nil, errors.New("404 Not Found"))
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Doit.Set(config.NS, doctl.ArgDropletWithAgentInfo, true)
+		config.Doit.Set(config.NS, doctl.ArgFormat, "ID,MetricsAgent")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := RunDropletList(config)
+		assert.NoError(t, err)
+		assert.Equal(t, "1    reporting\n2    N/A\n", buf.String())
+	})
+}
+
+func TestDropletsListTags(t *testing.T) {
+	droplets := do.Droplets{
+		{Droplet: &godo.Droplet{ID: 1, Image: &godo.Image{}, Region: &godo.Region{}, Tags: []string{"web", "prod", "api", "blue"}}},
+	}
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.droplets.EXPECT().List().Return(droplets, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+
+		err := RunDropletList(config)
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), "api,blue,prod,...")
+	})
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.droplets.EXPECT().List().Return(droplets, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Doit.Set(config.NS, doctl.ArgFormat, "ID,Tags")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := RunDropletList(config)
+		assert.NoError(t, err)
+		assert.Equal(t, "1    api,blue,prod,web\n", buf.String())
+	})
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.droplets.EXPECT().List().Return(droplets, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Doit.Set(config.NS, doctl.ArgFormat, "id,tags")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := RunDropletList(config)
+		assert.NoError(t, err)
+		assert.Equal(t, "1    api,blue,prod,web\n", buf.String())
+	})
+}
+
+func TestDropletsListBackupPolicy(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		droplets := do.Droplets{
+			{Droplet: &godo.Droplet{ID: 1, Image: &godo.Image{}, Region: &godo.Region{}, Features: []string{"backups"}}},
+			{Droplet: &godo.Droplet{ID: 2, Image: &godo.Image{}, Region: &godo.Region{}}},
+		}
+		policies := do.DropletBackupPolicies{
+			{DropletBackupPolicy: &godo.DropletBackupPolicy{
+				DropletID:     1,
+				BackupEnabled: true,
+				BackupPolicy:  &godo.DropletBackupPolicyConfig{Plan: "weekly", Weekday: "SUN", Hour: 8},
+			}},
+		}
+		tm.droplets.EXPECT().List().Return(droplets, nil)
+		tm.droplets.EXPECT().ListBackupPolicies().Return(policies, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Doit.Set(config.NS, doctl.ArgFormat, "ID,BackupEnabled,BackupPolicy")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := RunDropletList(config)
+		assert.NoError(t, err)
+		assert.Equal(t, "1    true     weekly SUN 08:00 UTC\n2    false    \n", buf.String())
+	})
+}
+
+func TestDropletsListSortBy(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		droplets := do.Droplets{
+			{Droplet: &godo.Droplet{ID: 1, Name: "web", Memory: 2048, Image: &godo.Image{}, Region: &godo.Region{}}},
+			{Droplet: &godo.Droplet{ID: 2, Name: "db", Memory: 8192, Image: &godo.Image{}, Region: &godo.Region{}}},
+			{Droplet: &godo.Droplet{ID: 3, Name: "cache", Memory: 1024, Image: &godo.Image{}, Region: &godo.Region{}}},
+		}
+		tm.droplets.EXPECT().List().Return(droplets, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Doit.Set(config.NS, doctl.ArgSortBy, "memory")
+		config.Doit.Set(config.NS, doctl.ArgFormat, "ID")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := RunDropletList(config)
+		assert.NoError(t, err)
+		assert.Equal(t, "3\n1\n2\n", buf.String())
+	})
+}
+
+func TestDropletsListSortByCreated(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		droplets := do.Droplets{
+			{Droplet: &godo.Droplet{ID: 1, Created: "2024-03-01T08:00:00Z", Image: &godo.Image{}, Region: &godo.Region{}}},
+			{Droplet: &godo.Droplet{ID: 2, Created: "2024-01-15T10:30:00Z", Image: &godo.Image{}, Region: &godo.Region{}}},
+			{Droplet: &godo.Droplet{ID: 3, Created: "2024-01-15T09:00:00-05:00", Image: &godo.Image{}, Region: &godo.Region{}}},
+		}
+		tm.droplets.EXPECT().List().Return(droplets, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Doit.Set(config.NS, doctl.ArgSortBy, "created")
+		config.Doit.Set(config.NS, doctl.ArgFormat, "ID,CreatedAt")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := RunDropletList(config)
+		assert.NoError(t, err)
+		assert.Equal(t, "2    2024-01-15 10:30:00\n3    2024-01-15 14:00:00\n1    2024-03-01 08:00:00\n", buf.String())
+	})
+}
+
+func TestDropletsListSSHConfig(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		private := do.Droplet{Droplet: &godo.Droplet{
+			ID:       2,
+			Name:     "internal",
+			Networks: &godo.Networks{V4: []godo.NetworkV4{{IPAddress: "10.0.0.2", Type: "private"}}},
+		}}
+		tm.droplets.EXPECT().List().Return(do.Droplets{testDroplet, private}, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Doit.Set(config.NS, doctl.ArgFormat, "ssh-config")
+
+		var err error
+		warnings := captureStderr(t, func() { err = RunDropletList(config) })
+		assert.NoError(t, err)
+		assert.Equal(t, "Host a-droplet\n  HostName 8.8.8.8\n  User root\n", buf.String())
+		assert.Contains(t, warnings, "Skipping Droplet internal: it has no public IPv4 address")
+	})
+}
+
+func TestDropletsListSortByInvalid(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Doit.Set(config.NS, doctl.ArgSortBy, "price")
+
+		err := RunDropletList(config)
+		assert.ErrorContains(t, err, `invalid value "price" for --sort-by`)
+	})
+}
+
 func TestDropletsTag(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		trr := &godo.TagResourcesRequest{
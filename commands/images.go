@@ -16,6 +16,7 @@ package commands
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/commands/displayers"
@@ -51,10 +52,17 @@ Currently, there are five types of images: snapshots, backups, custom images, di
 - Whether the image is public or not. An public image is available to all accounts. A private image is only accessible from your account. This is boolean value, true or false.
 - The minimum Droplet disk size required for a Droplet to use this image, in GB.
 `
-	cmdImagesList := CmdBuilder(cmd, RunImagesList, "list", "List images on your account", `Lists all private images on your account. To list public images, use the `+"`"+`--public`+"`"+` flag. This command returns the following information about each image:`+imageDetail, Writer,
+	cmdImagesList := CmdBuilder(cmd, RunImagesList, "list", "List images on your account", `Lists all private images on your account. To include public images, use the `+"`"+`--public`+"`"+` flag. To list only public images, use the `+"`"+`--public-only`+"`"+` flag, and to list only the private images on your account, use the `+"`"+`--private`+"`"+` flag. The `+"`"+`--distribution`+"`"+`, `+"`"+`--type`+"`"+`, and `+"`"+`--min-size-gb`+"`"+` flags further filter the list. This command returns the following information about each image:`+imageDetail, Writer,
 		aliasOpt("ls"), displayerType(&displayers.Image{}))
 	AddBoolFlag(cmdImagesList, doctl.ArgImagePublic, "", false, "Lists public images")
-	cmdImagesList.Example = `The following example lists all private images on your account and uses the ` + "`" + `--format` + "`" + ` flag to return only the ID, distribution, slug and created for each image: doctl compute image list --format ID,Distribution,Slug,Created`
+	AddBoolFlag(cmdImagesList, doctl.ArgImagePrivate, "", false, "Lists only the private images on your account, even when `--public` is set")
+	AddBoolFlag(cmdImagesList, doctl.ArgImagePublicOnly, "", false, "Lists only public images")
+	AddStringFlag(cmdImagesList, doctl.ArgImageDistribution, "", "", "Lists only images of the given distribution, such as `Ubuntu`. The match is case-insensitive.")
+	AddStringFlag(cmdImagesList, doctl.ArgImageType, "", "", "Lists only images of the given type, such as `snapshot`, `backup`, or `custom`")
+	AddFloatFlag(cmdImagesList, doctl.ArgImageMinSizeGB, "", 0, "Lists only images whose size is at least the given number of GB. Combine with `--type custom` to find large custom images to clean up.")
+	cmdImagesList.Example = `The following example lists all private images on your account and uses the ` + "`" + `--format` + "`" + ` flag to return only the ID, distribution, slug and created for each image: doctl compute image list --format ID,Distribution,Slug,Created
+
+The following example lists the public Ubuntu images: doctl compute image list --public-only --distribution ubuntu`
 
 	cmdImagesListDistribution := CmdBuilder(cmd, RunImagesListDistribution,
 		"list-distribution", "List available distribution images", `Lists the distribution images available from DigitalOcean. This command returns the following information about each image:`+imageDetail, Writer,
@@ -88,7 +96,7 @@ Currently, there are five types of images: snapshots, backups, custom images, di
 	AddBoolFlag(cmdRunImagesDelete, doctl.ArgForce, doctl.ArgShortForce, false, "Force image delete")
 	cmdRunImagesDelete.Example = `The following example deletes an image with the ID ` + "`" + `386734086` + "`" + `: doctl compute image delete 386734086`
 
-	cmdRunImagesCreate := CmdBuilder(cmd, RunImagesCreate, "create <image-name>", "Create custom image", `Creates an image in your DigitalOcean account. Specify a URL to download the image from and the region to store the image in. You can add additional metadata to the image using the optional flags.`, Writer)
+	cmdRunImagesCreate := CmdBuilder(cmd, RunImagesCreate, "create <image-name>", "Create custom image", `Creates an image in your DigitalOcean account. Specify a URL to download the image from and the region to store the image in. You can add additional metadata to the image using the optional flags.`, Writer, displayerType(&displayers.Image{}))
 	AddStringFlag(cmdRunImagesCreate, doctl.ArgImageExternalURL, "", "", "The URL to retrieve the image from", requiredOpt())
 	AddStringFlag(cmdRunImagesCreate, doctl.ArgRegionSlug, "", "", "The `slug` of the region you want to store the image in. For a list of region slugs, use the `doctl compute region list` command.", requiredOpt())
 	AddStringFlag(cmdRunImagesCreate, doctl.ArgImageDistro, "", "Unknown", "A custom image distribution slug to apply to the image")
@@ -108,16 +116,61 @@ func RunImagesList(c *CmdConfig) error {
 		return err
 	}
 
-	list, err := is.List(public)
+	private, err := c.Doit.GetBool(c.NS, doctl.ArgImagePrivate)
+	if err != nil {
+		return err
+	}
+
+	publicOnly, err := c.Doit.GetBool(c.NS, doctl.ArgImagePublicOnly)
 	if err != nil {
 		return err
 	}
+	if private && publicOnly {
+		return fmt.Errorf("The --%s and --%s flags are mutually exclusive.", doctl.ArgImagePrivate, doctl.ArgImagePublicOnly)
+	}
 
-	if !public && len(list) < 1 {
+	distribution, err := c.Doit.GetString(c.NS, doctl.ArgImageDistribution)
+	if err != nil {
+		return err
+	}
+
+	imageType, err := c.Doit.GetString(c.NS, doctl.ArgImageType)
+	if err != nil {
+		return err
+	}
+
+	minSize, err := c.Doit.GetFloat64(c.NS, doctl.ArgImageMinSizeGB)
+	if err != nil {
+		return err
+	}
+
+	list, err := is.List(public || publicOnly)
+	if err != nil {
+		return err
+	}
+
+	if !public && !publicOnly && len(list) < 1 {
 		notice("Listing private images. Use '--public' to include all images.")
 	}
 
-	item := &displayers.Image{Images: list}
+	filtered := make(do.Images, 0, len(list))
+	for _, i := range list {
+		if (i.Public && private) || (!i.Public && publicOnly) {
+			continue
+		}
+		if distribution != "" && !strings.EqualFold(i.Distribution, distribution) {
+			continue
+		}
+		if imageType != "" && !strings.EqualFold(i.Type, imageType) {
+			continue
+		}
+		if i.SizeGigaBytes < minSize {
+			continue
+		}
+		filtered = append(filtered, i)
+	}
+
+	item := &displayers.Image{Images: filtered}
 	return c.Display(item)
 }
 
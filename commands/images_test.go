@@ -14,10 +14,13 @@ limitations under the License.
 package commands
 
 import (
+	"bytes"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/digitalocean/doctl"
+	"github.com/digitalocean/doctl/do"
 	"github.com/digitalocean/godo"
 	"github.com/stretchr/testify/assert"
 )
@@ -37,6 +40,62 @@ func TestImagesList(t *testing.T) {
 	})
 }
 
+func TestImagesListFilters(t *testing.T) {
+	images := do.Images{
+		{Image: &godo.Image{ID: 1, Distribution: "Ubuntu", Type: "base", Public: true, SizeGigaBytes: 2.5}},
+		{Image: &godo.Image{ID: 2, Distribution: "Debian", Type: "base", Public: true, SizeGigaBytes: 1}},
+		{Image: &godo.Image{ID: 3, Distribution: "Ubuntu", Type: "snapshot", SizeGigaBytes: 10}},
+		{Image: &godo.Image{ID: 4, Distribution: "Ubuntu", Type: "backup", SizeGigaBytes: 25}},
+	}
+
+	tests := []struct {
+		name     string
+		flags    map[string]any
+		expected []string
+	}{
+		{name: "public and private", flags: map[string]any{doctl.ArgImagePublic: true}, expected: []string{"1", "2", "3", "4"}},
+		{name: "public only", flags: map[string]any{doctl.ArgImagePublicOnly: true}, expected: []string{"1", "2"}},
+		{name: "private only", flags: map[string]any{doctl.ArgImagePublic: true, doctl.ArgImagePrivate: true}, expected: []string{"3", "4"}},
+		{name: "distribution", flags: map[string]any{doctl.ArgImagePublicOnly: true, doctl.ArgImageDistribution: "ubuntu"}, expected: []string{"1"}},
+		{name: "type", flags: map[string]any{doctl.ArgImagePublic: true, doctl.ArgImageType: "Snapshot"}, expected: []string{"3"}},
+		{name: "min size", flags: map[string]any{doctl.ArgImagePublic: true, doctl.ArgImageMinSizeGB: 2.5}, expected: []string{"1", "3", "4"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+				tm.images.EXPECT().List(true).Return(images, nil)
+
+				var buf bytes.Buffer
+				config.Out = &buf
+				config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+				for k, v := range tt.flags {
+					config.Doit.Set(config.NS, k, v)
+				}
+
+				err := RunImagesList(config)
+				assert.NoError(t, err)
+
+				var ids []string
+				for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+					ids = append(ids, strings.Fields(line)[0])
+				}
+				assert.Equal(t, tt.expected, ids)
+			})
+		})
+	}
+}
+
+func TestImagesListPrivateAndPublicOnly(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Doit.Set(config.NS, doctl.ArgImagePrivate, true)
+		config.Doit.Set(config.NS, doctl.ArgImagePublicOnly, true)
+
+		err := RunImagesList(config)
+		assert.EqualError(t, err, "The --private and --public-only flags are mutually exclusive.")
+	})
+}
+
 func TestImagesListDistribution(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		tm.images.EXPECT().ListDistribution(false).Return(testImageList, nil)
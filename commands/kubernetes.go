@@ -19,10 +19,12 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/blang/semver"
@@ -30,6 +32,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/commands/displayers"
@@ -237,7 +240,8 @@ func kubernetesCluster() *Command {
 - The slug identifying the region where the Kubernetes cluster is located
 - The slug identifying the cluster's Kubernetes version. If set to a minor version, the latest patch version for that minor version is returned. For example, if the cluster is set to  ` + "`" + `1.14` + "`" + `, the command would return ` + "`" + `1.14.6-do.1` + "`" + `. If it is set to ` + "`" + `latest` + "`" + `, the latest published version is used.
 - A boolean value indicating whether the cluster automatically upgrades to new patch releases during its maintenance window.
-- An object containing a "state" attribute whose value is set to a string indicating the current status of the node. Potential values: ` + "`" + `running` + "`" + `, ` + "`" + `provisioning` + "`" + `, ` + "`" + `errored` + "`" + `.`
+- An object containing a "state" attribute whose value is set to a string indicating the current status of the node. Potential values: ` + "`" + `running` + "`" + `, ` + "`" + `provisioning` + "`" + `, ` + "`" + `errored` + "`" + `.
+- The total number of nodes across all of the cluster's node pools`
 
 	cmdKubernetesClusterGet := CmdBuilder(cmd, k8sCmdService.RunKubernetesClusterGet, "get <id|name>", "Retrieve details about a Kubernetes cluster", `
 Retrieves the following details about a Kubernetes cluster: `+clusterDetails+`
@@ -250,12 +254,19 @@ Retrieves the following details about a Kubernetes cluster: `+clusterDetails+`
 - When the Kubernetes cluster was last updated, in ISO8601 combined date and time format
 `+nodePoolDetails,
 		Writer, aliasOpt("g"), displayerType(&displayers.KubernetesClusters{}))
+	AddBoolFlag(cmdKubernetesClusterGet, doctl.ArgClusterWithEvents, "", false,
+		"Shows the cluster's five most recent status messages, such as the reason it is not running. With `--output json`, the messages are added to the cluster in an `events` field. The API only reports a timestamp and a message for each one, so there is no event type or reason as in `kubectl get events`.")
 	cmdKubernetesClusterGet.Example = `The following example retrieve details about a Kubernetes cluster named ` + "`" + `example-cluster` + "`" + `: doctl kubernetes cluster get example-cluster`
 
 	KubernetesClusterList := CmdBuilder(cmd, k8sCmdService.RunKubernetesClusterList, "list", "Retrieve the list of Kubernetes clusters for your account", `
 Retrieves the following details about all Kubernetes clusters that are on your account:`+clusterDetails+nodePoolDetails,
 		Writer, aliasOpt("ls"), displayerType(&displayers.KubernetesClusters{}))
-	KubernetesClusterList.Example = `The following example retrieves the list of Kubernetes clusters for your account and uses the ` + "`" + `--format` + "`" + ` flag to return only the name and endpoint for each cluster: doctl kubernetes cluster list --format Name,Endpoint`
+	AddBoolFlag(KubernetesClusterList, doctl.ArgClusterWithUpgradeInfo, "", false,
+		"Adds an `UpgradeAvailable` column indicating whether a newer Kubernetes version is available for each cluster. This makes one additional API request per cluster.")
+	AddStringFlag(KubernetesClusterList, doctl.ArgRegionSlug, "", "", "Lists only the clusters in the given region, such as `nyc1`")
+	KubernetesClusterList.Example = `The following example retrieves the list of Kubernetes clusters for your account and uses the ` + "`" + `--format` + "`" + ` flag to return only the name and endpoint for each cluster: doctl kubernetes cluster list --format Name,Endpoint
+
+The following example lists the clusters in the ` + "`" + `nyc1` + "`" + ` region and whether each can be upgraded: doctl kubernetes cluster list --region nyc1 --with-upgrade-info`
 
 	cmdKubernetesClusterGetUpgrades := CmdBuilder(cmd, k8sCmdService.RunKubernetesClusterGetUpgrades, "get-upgrades <id|name>",
 		"Retrieve a list of available Kubernetes version upgrades", `
@@ -270,7 +281,7 @@ Creates a Kubernetes cluster given the specified options and using the specified
 If no configuration flags are used, a three-node cluster with a single node pool is created in the `+"`"+`nyc1`+"`"+` region, using the latest Kubernetes version.
 
 After creating a cluster, a configuration context is added to kubectl and made active so that you can begin managing your new cluster immediately.`,
-		Writer, aliasOpt("c"))
+		Writer, aliasOpt("c"), displayerType(&displayers.KubernetesClusters{}))
 	AddStringFlag(cmdKubeClusterCreate, doctl.ArgRegionSlug, "", defaultKubernetesRegion,
 		"A `slug` indicating which region to create the cluster in. Use the `doctl kubernetes options regions` command for a list of options", requiredOpt())
 	AddStringFlag(cmdKubeClusterCreate, doctl.ArgClusterVersionSlug, "", "latest",
@@ -386,6 +397,12 @@ For example, if a cluster is on 1.12.1 and upgrades are available to 1.12.3 and
 	cmdKubeClusterDelete := CmdBuilder(cmd, k8sCmdService.RunKubernetesClusterDelete,
 		"delete <id|name>...", "Delete Kubernetes clusters ", `
 Deletes the specified Kubernetes clusters and the Droplets associated with them. To delete all other DigitalOcean resources created during the operation of the clusters, such as load balancers, volumes or volume snapshots, use the `+"`"+`--dangerous`+"`"+` flag.
+
+To delete only the cluster's load balancers and volumes while keeping its volume snapshots, use the `+"`"+`--cascade`+"`"+` flag instead. It prints each resource as it is deleted. To prevent accidental cascading deletes, `+"`"+`--cascade`+"`"+` must be used together with `+"`"+`--force`+"`"+`.
+
+The `+"`"+`--destroy-associated-resources`+"`"+` flag is an alias of `+"`"+`--dangerous`+"`"+`. With either flag and without `+"`"+`--force`+"`"+`, the resources about to be deleted are listed before asking for confirmation.
+
+Before asking for confirmation, the command lists the associated resources that will be left behind and continue to incur charges.
 `, Writer, aliasOpt("d", "rm"))
 	AddBoolFlag(cmdKubeClusterDelete, doctl.ArgForce, doctl.ArgShortForce, false,
 		"Deletes the cluster without a confirmation prompt")
@@ -393,7 +410,15 @@ Deletes the specified Kubernetes clusters and the Droplets associated with them.
 		"Remove the deleted cluster from your kubeconfig")
 	AddBoolFlag(cmdKubeClusterDelete, doctl.ArgDangerous, "", false,
 		"Deletes the cluster's associated resources like load balancers, volumes and volume snapshots")
-	cmdKubeClusterDelete.Example = `The following example deletes a cluster named ` + "`" + `example-cluster` + "`" + `: doctl kubernetes cluster delete example-cluster`
+	AddBoolFlag(cmdKubeClusterDelete, doctl.ArgCascade, "", false,
+		"Deletes the load balancers and volumes associated with the cluster, printing each resource as it is cleaned up. Unlike `--dangerous`, volume snapshots are kept. Requires `--force` and cannot be used together with `--dangerous`")
+	AddBoolFlag(cmdKubeClusterDelete, doctl.ArgDestroyAssociatedResources, "", false,
+		"Alias of `--dangerous`")
+	cmdKubeClusterDelete.Example = `The following example deletes a cluster named ` + "`" + `example-cluster` + "`" + `: doctl kubernetes cluster delete example-cluster
+
+The following example deletes a cluster named ` + "`" + `example-cluster` + "`" + ` along with its load balancers and volumes: doctl kubernetes cluster delete example-cluster --force --cascade
+
+The following example deletes a cluster named ` + "`" + `example-cluster` + "`" + ` along with all of its associated resources: doctl kubernetes cluster delete example-cluster --destroy-associated-resources`
 
 	cmdKubeClusterDeleteSelective := CmdBuilder(cmd, k8sCmdService.RunKubernetesClusterDeleteSelective,
 		"delete-selective <id|name>", "Delete a Kubernetes cluster and selectively delete resources associated with it", `
@@ -484,8 +509,10 @@ Retrieves information about the specified node pool in the specified cluster, in
 - The node pool ID
 - The slug indicating the machine size of the nodes, such as `+"`"+`s-1vcpu-2gb`+"`"+`
 - The number of nodes in the pool
-- The tags applied to the node pool
+- The minimum and maximum number of nodes if auto-scaling is enabled
+- The tags, labels, and taints applied to the node pool
 - The names of the nodes
+- The state of each node, such as `+"`"+`running`+"`"+` or `+"`"+`provisioning`+"`"+`
 
 Specifying `+"`"+`--output=json`+"`"+` when calling this command returns additional information about the individual nodes in the response, such as their IDs, status, creation time, and update time.
 `, Writer, aliasOpt("g"),
@@ -511,7 +538,7 @@ Specifying `+"`"+`--output=json`+"`"+` when calling this command returns additio
 		"create <cluster-id|cluster-name>", "Create a new node pool for a cluster", `
 Creates a new node pool for the specified cluster. The command requires values for the `+"`"+`--name`+"`"+`, `+"`"+`--size`+"`"+`, and `+"`"+`--count`+"`"+` flags to create a node pool. You can also specify that you'd like to enable autoscaling and set minimum and maximum node poll sizes.
 		`,
-		Writer, aliasOpt("c"))
+		Writer, aliasOpt("c"), displayerType(&displayers.KubernetesNodePools{}))
 	AddStringFlag(cmdKubeNodePoolCreate, doctl.ArgNodePoolName, "", "",
 		"The name of the node pool", requiredOpt())
 	AddStringFlag(cmdKubeNodePoolCreate, doctl.ArgSizeSlug, "", "",
@@ -560,9 +587,13 @@ Creates a new node pool for the specified cluster. The command requires values f
 
 	cmdKubeNodePoolDelete := CmdBuilder(cmd, k8sCmdService.RunKubernetesNodePoolDelete,
 		"delete <cluster-id|cluster-name> <pool-id|pool-name>",
-		"Delete a node pool", `Deletes a node pool in a cluster, which also removes all the nodes inside that pool. You cannot reverse this action.`, Writer, aliasOpt("d", "rm"))
+		"Delete a node pool", `Deletes a node pool in a cluster, which also removes all the nodes inside that pool. You cannot reverse this action.
+
+When the `+"`"+`--drain-timeout`+"`"+` flag is set, each node in the pool is cordoned and drained with `+"`"+`kubectl drain`+"`"+` before the node pool is deleted, so that pods are evicted gracefully. This requires `+"`"+`kubectl`+"`"+` and the cluster's credentials in your local kubeconfig, which you can add with `+"`"+`doctl kubernetes cluster kubeconfig save`+"`"+`. If `+"`"+`kubectl`+"`"+` is not installed, the nodes are not drained.`, Writer, aliasOpt("d", "rm"))
 	AddBoolFlag(cmdKubeNodePoolDelete, doctl.ArgForce, doctl.ArgShortForce,
 		false, "Deletes node pool without a confirmation prompt")
+	AddDurationFlag(cmdKubeNodePoolDelete, doctl.ArgNodePoolDrainTimeout, "", 0,
+		"Drain each node with `kubectl drain` before deleting the node pool, waiting up to this long per node, e.g. `5m`")
 	cmdKubeNodePoolDelete.Example = `The following example deletes a node pool named ` + "`" + `example-pool` + "`" + ` in a cluster named ` + "`" + `example-cluster` + "`" + `: doctl kubernetes cluster node-pool delete example-cluster example-pool`
 
 	cmdKubeNodeDelete := CmdBuilder(cmd, k8sCmdService.RunKubernetesNodeDelete, "delete-node <cluster-id|cluster-name> <pool-id|pool-name> <node-id>", "Delete a node", `
@@ -697,21 +728,77 @@ func (s *KubernetesCommandService) RunKubernetesClusterGet(c *CmdConfig) error {
 	}
 	clusterIDorName := c.Args[0]
 
-	cluster, err := clusterByIDorName(c.Kubernetes(), clusterIDorName)
+	kube := c.Kubernetes()
+	cluster, err := clusterByIDorName(kube, clusterIDorName)
 	if err != nil {
 		return err
 	}
-	return displayClusters(c, false, *cluster)
+
+	withEvents, err := c.Doit.GetBool(c.NS, doctl.ArgClusterWithEvents)
+	if err != nil {
+		return err
+	}
+	if !withEvents {
+		return displayClusters(c, false, *cluster)
+	}
+
+	events, err := kube.GetStatusMessages(cluster.ID)
+	if err != nil {
+		return err
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Timestamp.Before(events[j].Timestamp)
+	})
+	events = events[max(len(events)-clusterEventsShown, 0):]
+
+	item := &displayers.KubernetesClusters{
+		KubernetesClusters: do.KubernetesClusters{*cluster},
+		Events:             map[string][]*godo.KubernetesClusterStatusMessage{cluster.ID: events},
+	}
+	if err := c.Display(item); err != nil {
+		return err
+	}
+	if Output != "text" {
+		return nil
+	}
+
+	fmt.Fprintln(c.Out)
+	if len(events) == 0 {
+		fmt.Fprintln(c.Out, "No recent events.")
+		return nil
+	}
+	// --format and --no-header apply to the cluster, so the events table is
+	// always displayed with its own columns and header.
+	return displayers.DisplayText(&displayers.KubernetesClusterEvents{Events: events}, c.Out, false, nil)
 }
 
+// clusterEventsShown is the number of status messages shown by
+// `kubernetes cluster get --with-events`.
+const clusterEventsShown = 5
+
 // RunKubernetesClusterList lists kubernetes.
 func (s *KubernetesCommandService) RunKubernetesClusterList(c *CmdConfig) error {
+	region, err := c.Doit.GetString(c.NS, doctl.ArgRegionSlug)
+	if err != nil {
+		return err
+	}
+
 	kube := c.Kubernetes()
 	list, err := kube.List()
 	if err != nil {
 		return err
 	}
 
+	if region != "" {
+		inRegion := make(do.KubernetesClusters, 0, len(list))
+		for _, cluster := range list {
+			if cluster.RegionSlug == region {
+				inRegion = append(inRegion, cluster)
+			}
+		}
+		list = inRegion
+	}
+
 	// Check the format flag to determine if the displayer should use the short
 	// layout of the cluster display. List uses the short version, but to format
 	// output that includes columns not in the short layout we need the full version.
@@ -724,7 +811,47 @@ func (s *KubernetesCommandService) RunKubernetesClusterList(c *CmdConfig) error
 		short = false
 	}
 
-	return displayClusters(c, short, list...)
+	withUpgradeInfo, err := c.Doit.GetBool(c.NS, doctl.ArgClusterWithUpgradeInfo)
+	if err != nil {
+		return err
+	}
+
+	item := &displayers.KubernetesClusters{KubernetesClusters: list, Short: short}
+	if withUpgradeInfo {
+		item.UpgradeAvailable, err = clusterUpgradeAvailability(kube, list)
+		if err != nil {
+			return err
+		}
+	}
+	return c.Display(item)
+}
+
+// clusterUpgradeAvailability reports, for each cluster, whether any newer
+// Kubernetes version is available to upgrade to.
+func clusterUpgradeAvailability(kube do.KubernetesService, clusters do.KubernetesClusters) (map[string]bool, error) {
+	available := make(map[string]bool, len(clusters))
+	var mu sync.Mutex
+	var grp errgroup.Group
+	grp.SetLimit(resolveNamesConcurrency)
+	for _, cluster := range clusters {
+		grp.Go(func() error {
+			upgrades, err := kube.GetUpgrades(cluster.ID)
+			if err != nil {
+				return err
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			available[cluster.ID] = len(upgrades) > 0
+			return nil
+		})
+	}
+
+	if err := grp.Wait(); err != nil {
+		return nil, err
+	}
+
+	return available, nil
 }
 
 // RunKubernetesClusterGetUpgrades retrieves available upgrade versions for a cluster.
@@ -996,6 +1123,26 @@ func (s *KubernetesCommandService) RunKubernetesClusterDelete(c *CmdConfig) erro
 		return err
 	}
 
+	cascade, err := c.Doit.GetBool(c.NS, doctl.ArgCascade)
+	if err != nil {
+		return err
+	}
+
+	destroy, err := c.Doit.GetBool(c.NS, doctl.ArgDestroyAssociatedResources)
+	if err != nil {
+		return err
+	}
+
+	// --destroy-associated-resources is an alias of --dangerous
+	dangerous = dangerous || destroy
+
+	if cascade && dangerous {
+		return fmt.Errorf("The --%s and --%s flags are mutually exclusive.", doctl.ArgCascade, doctl.ArgDangerous)
+	}
+	if cascade && !force {
+		return fmt.Errorf("The --%s flag must be used together with --%s.", doctl.ArgCascade, doctl.ArgForce)
+	}
+
 	kube := c.Kubernetes()
 
 	for _, cluster := range c.Args {
@@ -1004,6 +1151,10 @@ func (s *KubernetesCommandService) RunKubernetesClusterDelete(c *CmdConfig) erro
 			return err
 		}
 
+		if !force {
+			warnAssociatedResources(kube, clusterID, dangerous)
+		}
+
 		if force || AskForConfirmDelete("Kubernetes cluster", 1) == nil {
 			// continue
 		} else {
@@ -1020,9 +1171,12 @@ func (s *KubernetesCommandService) RunKubernetesClusterDelete(c *CmdConfig) erro
 			}
 		}
 
-		if dangerous {
+		switch {
+		case dangerous:
 			err = kube.DeleteDangerous(clusterID)
-		} else {
+		case cascade:
+			err = deleteClusterCascade(kube, clusterID)
+		default:
 			err = kube.Delete(clusterID)
 		}
 		if err != nil {
@@ -1040,6 +1194,70 @@ func (s *KubernetesCommandService) RunKubernetesClusterDelete(c *CmdConfig) erro
 	return nil
 }
 
+// warnAssociatedResources prints the resources associated with a cluster. With
+// dangerous, they are listed as resources about to be deleted; otherwise, as
+// resources that are not removed when only the cluster is deleted.
+func warnAssociatedResources(kube do.KubernetesService, clusterID string, dangerous bool) {
+	resources, err := kube.ListAssociatedResourcesForDeletion(clusterID)
+	if err != nil {
+		warn("Couldn't list the resources associated with the cluster: %v", err)
+		return
+	}
+
+	var leftover []string
+	for _, lb := range resources.LoadBalancers {
+		leftover = append(leftover, fmt.Sprintf("  load balancer %s (%s)", lb.Name, lb.ID))
+	}
+	for _, v := range resources.Volumes {
+		leftover = append(leftover, fmt.Sprintf("  volume %s (%s)", v.Name, v.ID))
+	}
+	for _, s := range resources.VolumeSnapshots {
+		leftover = append(leftover, fmt.Sprintf("  volume snapshot %s (%s)", s.Name, s.ID))
+	}
+	if len(leftover) == 0 {
+		return
+	}
+
+	if dangerous {
+		warn("The following resources associated with the cluster will be deleted together with it:\n%s", strings.Join(leftover, "\n"))
+		return
+	}
+	warn("The following resources associated with the cluster will not be deleted and will continue to incur charges. Use `--%s` to delete them with the cluster.\n%s", doctl.ArgDestroyAssociatedResources, strings.Join(leftover, "\n"))
+}
+
+// deleteClusterCascade deletes a cluster along with the load balancers and
+// volumes associated with it. Volume snapshots are kept and listed once the
+// cluster is gone.
+func deleteClusterCascade(kube do.KubernetesService, clusterID string) error {
+	resources, err := kube.ListAssociatedResourcesForDeletion(clusterID)
+	if err != nil {
+		return err
+	}
+
+	r := new(godo.KubernetesClusterDeleteSelectiveRequest)
+	for _, lb := range resources.LoadBalancers {
+		notice("Deleting load balancer %s (%s)", lb.Name, lb.ID)
+		r.LoadBalancers = append(r.LoadBalancers, lb.ID)
+	}
+	for _, v := range resources.Volumes {
+		notice("Detaching and deleting volume %s (%s)", v.Name, v.ID)
+		r.Volumes = append(r.Volumes, v.ID)
+	}
+	kept := make([]string, 0, len(resources.VolumeSnapshots))
+	for _, s := range resources.VolumeSnapshots {
+		kept = append(kept, fmt.Sprintf("  volume snapshot %s (%s)", s.Name, s.ID))
+	}
+
+	if err := kube.DeleteSelective(clusterID, r); err != nil {
+		return err
+	}
+
+	if len(kept) > 0 {
+		notice("The following volume snapshots associated with the cluster were kept and will continue to incur charges:\n%s", strings.Join(kept, "\n"))
+	}
+	return nil
+}
+
 func (s *KubernetesCommandService) RunKubernetesClusterDeleteSelective(c *CmdConfig) error {
 	err := ensureOneArg(c)
 	if err != nil {
@@ -1402,7 +1620,11 @@ func (s *KubernetesCommandService) RunKubernetesNodePoolGet(c *CmdConfig) error
 	if err != nil {
 		return err
 	}
-	return displayNodePools(c, *nodePool)
+	item := &displayers.KubernetesNodePools{
+		KubernetesNodePools: do.KubernetesNodePools{*nodePool},
+		ShowNodeStatus:      true,
+	}
+	return c.Display(item)
 }
 
 // RunKubernetesNodePoolList lists cluster node pool.
@@ -1518,8 +1740,17 @@ func (s *KubernetesCommandService) RunKubernetesNodePoolDelete(c *CmdConfig) err
 	if err != nil {
 		return err
 	}
+	drainTimeout, err := c.Doit.GetDuration(c.NS, doctl.ArgNodePoolDrainTimeout)
+	if err != nil {
+		return err
+	}
 	if force || AskForConfirmDelete("Kubernetes node pool", 1) == nil {
 		kube := c.Kubernetes()
+		if drainTimeout > 0 {
+			if err := s.drainNodePool(kube, clusterID, poolID, drainTimeout); err != nil {
+				return err
+			}
+		}
 		if err := kube.DeleteNodePool(clusterID, poolID); err != nil {
 			return err
 		}
@@ -1529,6 +1760,72 @@ func (s *KubernetesCommandService) RunKubernetesNodePoolDelete(c *CmdConfig) err
 	return nil
 }
 
+// lookPath stores exec.LookPath in a variable so it can be overridden while testing.
+var lookPath = exec.LookPath
+
+// drainNodePool cordons and drains every node of a node pool using kubectl and
+// the cluster's context from the local kubeconfig.
+func (s *KubernetesCommandService) drainNodePool(kube do.KubernetesService, clusterID, poolID string, timeout time.Duration) error {
+	kubectl, err := lookPath("kubectl")
+	if err != nil {
+		warn("kubectl was not found in your PATH; deleting the node pool without draining its nodes")
+		return nil
+	}
+
+	cluster, err := kube.Get(clusterID)
+	if err != nil {
+		return err
+	}
+	kubeContext, err := s.kubeconfigContextForCluster(cluster)
+	if err != nil {
+		return err
+	}
+
+	pool, err := kube.GetNodePool(clusterID, poolID)
+	if err != nil {
+		return err
+	}
+
+	for _, node := range pool.Nodes {
+		notice("Draining node %s", node.Name)
+		cmd := execCommand(kubectl, "--context", kubeContext, "drain", node.Name,
+			"--ignore-daemonsets", "--delete-emptydir-data", "--timeout", timeout.String())
+		cmd.Stdout = os.Stderr
+		cmd.Stderr = os.Stderr
+		if err := cmd.Run(); err != nil {
+			return fmt.Errorf("failed to drain node %s: %w", node.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// kubeconfigContextForCluster returns the name of a local kubeconfig context
+// pointing to the given cluster, as saved by `doctl kubernetes cluster kubeconfig save`.
+func (s *KubernetesCommandService) kubeconfigContextForCluster(cluster *do.KubernetesCluster) (string, error) {
+	config, err := s.KubeconfigProvider.Local()
+	if err != nil {
+		return "", err
+	}
+
+	clusterName := fmt.Sprintf("do-%s-%s", cluster.RegionSlug, cluster.Name)
+	if ctx, ok := config.Contexts[clusterName]; ok && ctx.Cluster == clusterName {
+		return clusterName, nil
+	}
+	names := make([]string, 0, len(config.Contexts))
+	for name, ctx := range config.Contexts {
+		if ctx.Cluster == clusterName {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return "", fmt.Errorf("no kubeconfig context found for cluster %s; run `doctl kubernetes cluster kubeconfig save %s` first", cluster.Name, cluster.ID)
+	}
+	sort.Strings(names)
+
+	return names[0], nil
+}
+
 // RunKubernetesNodeDelete deletes a Kubernetes Node
 func (s *KubernetesCommandService) RunKubernetesNodeDelete(c *CmdConfig) error {
 	return kubernetesNodeDelete(false, c)
@@ -1,9 +1,13 @@
 package commands
 
 import (
+	"bytes"
 	"fmt"
+	"os/exec"
 	"sort"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/digitalocean/godo"
 	"github.com/google/uuid"
@@ -318,6 +322,61 @@ func TestKubernetesGet(t *testing.T) {
 	})
 }
 
+func TestKubernetesGetWithEvents(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		var messages []*godo.KubernetesClusterStatusMessage
+		for i := 6; i >= 0; i-- {
+			messages = append(messages, &godo.KubernetesClusterStatusMessage{
+				Message:   fmt.Sprintf("message %d", i),
+				Timestamp: base.Add(time.Duration(i) * time.Minute),
+			})
+		}
+
+		tm.kubernetes.EXPECT().Get(testCluster.ID).Return(&testCluster, nil)
+		tm.kubernetes.EXPECT().GetStatusMessages(testCluster.ID).Return(messages, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Args = append(config.Args, testCluster.ID)
+		config.Doit.Set(config.NS, doctl.ArgClusterWithEvents, true)
+
+		err := testK8sCmdService().RunKubernetesClusterGet(config)
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), "Timestamp")
+		assert.NotContains(t, buf.String(), "message 1")
+		assert.Contains(t, buf.String(), "message 2")
+		assert.Contains(t, buf.String(), "message 6")
+		assert.Less(t, strings.Index(buf.String(), "message 2"), strings.Index(buf.String(), "message 6"))
+	})
+
+	// --format and --no-header only apply to the cluster
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		messages := []*godo.KubernetesClusterStatusMessage{
+			{Message: "cluster is provisioning", Timestamp: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+		}
+
+		tm.kubernetes.EXPECT().Get(testCluster.ID).Return(&testCluster, nil)
+		tm.kubernetes.EXPECT().GetStatusMessages(testCluster.ID).Return(messages, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Args = append(config.Args, testCluster.ID)
+		config.Doit.Set(config.NS, doctl.ArgClusterWithEvents, true)
+		config.Doit.Set(config.NS, doctl.ArgFormat, "Name")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := testK8sCmdService().RunKubernetesClusterGet(config)
+		assert.NoError(t, err)
+		expected := testCluster.Name + `
+
+Timestamp                        Message
+2026-01-01 00:00:00 +0000 UTC    cluster is provisioning
+`
+		assert.Equal(t, expected, buf.String())
+	})
+}
+
 func TestKubernetesGetUpgrades(t *testing.T) {
 	// by ID
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
@@ -454,6 +513,50 @@ func TestKubernetesList(t *testing.T) {
 	})
 }
 
+func TestKubernetesListWithUpgradeInfo(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		clusters := do.KubernetesClusters{
+			{KubernetesCluster: &godo.KubernetesCluster{ID: "a", Name: "current", NodePools: []*godo.KubernetesNodePool{{Count: 2}, {Count: 3}}}},
+			{KubernetesCluster: &godo.KubernetesCluster{ID: "b", Name: "outdated", NodePools: []*godo.KubernetesNodePool{{Count: 1}}}},
+		}
+		tm.kubernetes.EXPECT().List().Return(clusters, nil)
+		tm.kubernetes.EXPECT().GetUpgrades("a").Return(do.KubernetesVersions{}, nil)
+		tm.kubernetes.EXPECT().GetUpgrades("b").Return(do.KubernetesVersions{{KubernetesVersion: &godo.KubernetesVersion{Slug: "1.33.1-do.0"}}}, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Doit.Set(config.NS, doctl.ArgClusterWithUpgradeInfo, true)
+		config.Doit.Set(config.NS, doctl.ArgFormat, "Name,NodeCount,UpgradeAvailable")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := testK8sCmdService().RunKubernetesClusterList(config)
+		assert.NoError(t, err)
+		assert.Equal(t, "current     5    false\noutdated    1    true\n", buf.String())
+	})
+}
+
+func TestKubernetesListRegion(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		clusters := do.KubernetesClusters{
+			{KubernetesCluster: &godo.KubernetesCluster{ID: "a", Name: "east", RegionSlug: "nyc1"}},
+			{KubernetesCluster: &godo.KubernetesCluster{ID: "b", Name: "west", RegionSlug: "sfo3"}},
+		}
+		tm.kubernetes.EXPECT().List().Return(clusters, nil)
+		tm.kubernetes.EXPECT().GetUpgrades("b").Return(do.KubernetesVersions{}, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "sfo3")
+		config.Doit.Set(config.NS, doctl.ArgClusterWithUpgradeInfo, true)
+		config.Doit.Set(config.NS, doctl.ArgFormat, "Name,Region,UpgradeAvailable")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := testK8sCmdService().RunKubernetesClusterList(config)
+		assert.NoError(t, err)
+		assert.Equal(t, "west    sfo3    false\n", buf.String())
+	})
+}
+
 func TestKubernetesCreate(t *testing.T) {
 	testNodePool := testNodePool
 
@@ -766,12 +869,25 @@ func TestKubernetesUpgrade(t *testing.T) {
 
 func TestKubernetesDelete(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		// shouldn't call `DeleteNodePool` so we don't set any expectations
+		// shouldn't call `Delete` so we only expect the associated resources
+		// to be listed for the warning
+		resources := &do.KubernetesAssociatedResources{
+			KubernetesAssociatedResources: &godo.KubernetesAssociatedResources{
+				Volumes:       []*godo.AssociatedResource{{ID: volumeID.String(), Name: "pvc-volume"}},
+				LoadBalancers: []*godo.AssociatedResource{{ID: lbID.String(), Name: "ingress-lb"}},
+			},
+		}
+		tm.kubernetes.EXPECT().ListAssociatedResourcesForDeletion(testCluster.ID).Return(resources, nil)
+
 		config.Doit.Set(config.NS, doctl.ArgForce, "false")
 		config.Args = append(config.Args, testCluster.ID)
 
-		err := testK8sCmdService().RunKubernetesClusterDelete(config)
+		var err error
+		warnings := captureStderr(t, func() { err = testK8sCmdService().RunKubernetesClusterDelete(config) })
 		assert.Error(t, err, "should have been challenged before deletion")
+		assert.Contains(t, warnings, "load balancer ingress-lb ("+lbID.String()+")")
+		assert.Contains(t, warnings, "volume pvc-volume ("+volumeID.String()+")")
+		assert.Contains(t, warnings, "Use `--destroy-associated-resources` to delete them with the cluster.")
 	})
 	// by id
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
@@ -821,6 +937,80 @@ func TestKubernetesDelete(t *testing.T) {
 		err := testK8sCmdService().RunKubernetesClusterDelete(config)
 		assert.NoError(t, err)
 	})
+	// cascading delete
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		resources := &do.KubernetesAssociatedResources{
+			KubernetesAssociatedResources: &godo.KubernetesAssociatedResources{
+				Volumes:         []*godo.AssociatedResource{{ID: volumeID.String(), Name: "pvc-volume"}},
+				VolumeSnapshots: []*godo.AssociatedResource{{ID: snapshotID.String(), Name: "pvc-snapshot"}},
+				LoadBalancers:   []*godo.AssociatedResource{{ID: lbID.String(), Name: "ingress-lb"}},
+			},
+		}
+		r := &godo.KubernetesClusterDeleteSelectiveRequest{
+			Volumes:       []string{volumeID.String()},
+			LoadBalancers: []string{lbID.String()},
+		}
+		tm.kubernetes.EXPECT().ListAssociatedResourcesForDeletion(testCluster.ID).Return(resources, nil)
+		tm.kubernetes.EXPECT().DeleteSelective(testCluster.ID, r).Return(nil)
+
+		config.Args = append(config.Args, testCluster.ID)
+		config.Doit.Set(config.NS, doctl.ArgForce, "true")
+		config.Doit.Set(config.NS, doctl.ArgCascade, "true")
+
+		var err error
+		warnings := captureStderr(t, func() { err = testK8sCmdService().RunKubernetesClusterDelete(config) })
+		assert.NoError(t, err)
+		assert.Contains(t, warnings, "volume snapshot pvc-snapshot ("+snapshotID.String()+")")
+		assert.Contains(t, warnings, "volume snapshots associated with the cluster were kept")
+		assert.NotContains(t, warnings, "Re-run")
+	})
+	// --destroy-associated-resources is an alias of --dangerous
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.kubernetes.EXPECT().DeleteDangerous(testCluster.ID).Return(nil)
+
+		config.Args = append(config.Args, testCluster.ID)
+		config.Doit.Set(config.NS, doctl.ArgForce, "true")
+		config.Doit.Set(config.NS, doctl.ArgDestroyAssociatedResources, "true")
+
+		err := testK8sCmdService().RunKubernetesClusterDelete(config)
+		assert.NoError(t, err)
+	})
+	// resources about to be deleted are listed before the confirmation
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		resources := &do.KubernetesAssociatedResources{
+			KubernetesAssociatedResources: &godo.KubernetesAssociatedResources{
+				LoadBalancers: []*godo.AssociatedResource{{ID: lbID.String(), Name: "ingress-lb"}},
+			},
+		}
+		tm.kubernetes.EXPECT().ListAssociatedResourcesForDeletion(testCluster.ID).Return(resources, nil)
+
+		config.Args = append(config.Args, testCluster.ID)
+		config.Doit.Set(config.NS, doctl.ArgForce, "false")
+		config.Doit.Set(config.NS, doctl.ArgDestroyAssociatedResources, "true")
+
+		var err error
+		warnings := captureStderr(t, func() { err = testK8sCmdService().RunKubernetesClusterDelete(config) })
+		assert.Error(t, err, "should have been challenged before deletion")
+		assert.Contains(t, warnings, "will be deleted together with it:\n  load balancer ingress-lb ("+lbID.String()+")")
+	})
+	// cascading delete cannot be combined with dangerous delete
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Args = append(config.Args, testCluster.ID)
+		config.Doit.Set(config.NS, doctl.ArgForce, "true")
+		config.Doit.Set(config.NS, doctl.ArgCascade, "true")
+		config.Doit.Set(config.NS, doctl.ArgDangerous, "true")
+
+		err := testK8sCmdService().RunKubernetesClusterDelete(config)
+		assert.EqualError(t, err, "The --cascade and --dangerous flags are mutually exclusive.")
+	})
+	// cascading delete requires force
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Args = append(config.Args, testCluster.ID)
+		config.Doit.Set(config.NS, doctl.ArgCascade, "true")
+
+		err := testK8sCmdService().RunKubernetesClusterDelete(config)
+		assert.EqualError(t, err, "The --cascade flag must be used together with --force.")
+	})
 }
 
 func TestKubernetesDeleteSelective(t *testing.T) {
@@ -986,6 +1176,35 @@ func TestKubernetesNodePool_Get(t *testing.T) {
 	})
 }
 
+func TestKubernetesNodePool_GetAutoScaleAndNodeStatus(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		pool := do.KubernetesNodePool{
+			KubernetesNodePool: &godo.KubernetesNodePool{
+				ID:        testNodePool.ID,
+				Name:      testNodePool.Name,
+				AutoScale: true,
+				MinNodes:  1,
+				MaxNodes:  5,
+				Nodes: []*godo.KubernetesNode{
+					{Name: "node-a", Status: &godo.KubernetesNodeStatus{State: "running"}},
+					{Name: "node-b"},
+				},
+			},
+		}
+		tm.kubernetes.EXPECT().GetNodePool(testCluster.ID, testNodePool.ID).Return(&pool, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Args = append(config.Args, testCluster.ID, testNodePool.ID)
+		config.Doit.Set(config.NS, doctl.ArgFormat, "Name,AutoScale,NodeStatus")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := testK8sCmdService().RunKubernetesNodePoolGet(config)
+		assert.NoError(t, err)
+		assert.Equal(t, "antoine_s_pool    1-5    [node-a:running node-b:unknown]\n", buf.String())
+	})
+}
+
 func TestKubernetesNodePool_List(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		tm.kubernetes.EXPECT().ListNodePools(testCluster.ID).Return(testNodePools, nil)
@@ -1325,6 +1544,61 @@ func TestKubernetesNodePool_Delete(t *testing.T) {
 	})
 }
 
+func TestKubernetesNodePoolDeleteWithDrain(t *testing.T) {
+	origLookPath, origExecCommand := lookPath, execCommand
+	defer func() {
+		lookPath, execCommand = origLookPath, origExecCommand
+	}()
+
+	t.Run("drains each node before deleting", func(t *testing.T) {
+		withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+			tm.kubernetes.EXPECT().Get(testCluster.ID).Return(&testCluster, nil)
+			tm.kubernetes.EXPECT().GetNodePool(testCluster.ID, testNodePool.ID).Return(&testNodePool, nil)
+			tm.kubernetes.EXPECT().DeleteNodePool(testCluster.ID, testNodePool.ID).Return(nil)
+
+			lookPath = func(file string) (string, error) { return "/usr/bin/kubectl", nil }
+			var drained [][]string
+			execCommand = func(name string, args ...string) *exec.Cmd {
+				drained = append(drained, args)
+				return exec.Command("true")
+			}
+
+			k8sCmdService := testK8sCmdService()
+			provider := k8sCmdService.KubeconfigProvider.(*mockKubeconfigProvider)
+			provider.remote = clientcmdapi.Config{
+				Contexts: map[string]*clientcmdapi.Context{
+					"my-alias": {Cluster: "do-sfo2-antoine_s_cluster"},
+				},
+			}
+
+			config.Args = append(config.Args, testCluster.ID, testNodePool.ID)
+			config.Doit.Set(config.NS, doctl.ArgForce, "true")
+			config.Doit.Set(config.NS, doctl.ArgNodePoolDrainTimeout, "5m")
+
+			err := k8sCmdService.RunKubernetesNodePoolDelete(config)
+			assert.NoError(t, err)
+			assert.Equal(t, [][]string{
+				{"--context", "my-alias", "drain", "antoine_s_node", "--ignore-daemonsets", "--delete-emptydir-data", "--timeout", "5m0s"},
+			}, drained)
+		})
+	})
+
+	t.Run("skips draining without kubectl", func(t *testing.T) {
+		withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+			tm.kubernetes.EXPECT().DeleteNodePool(testCluster.ID, testNodePool.ID).Return(nil)
+
+			lookPath = func(file string) (string, error) { return "", exec.ErrNotFound }
+
+			config.Args = append(config.Args, testCluster.ID, testNodePool.ID)
+			config.Doit.Set(config.NS, doctl.ArgForce, "true")
+			config.Doit.Set(config.NS, doctl.ArgNodePoolDrainTimeout, "5m")
+
+			err := testK8sCmdService().RunKubernetesNodePoolDelete(config)
+			assert.NoError(t, err)
+		})
+	})
+}
+
 func TestKubernetesOptions_Versions(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		testVersions := do.KubernetesVersions{
@@ -14,10 +14,18 @@ limitations under the License.
 package commands
 
 import (
+	"crypto/tls"
 	_ "embed"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"net"
+	"net/http"
 	"os"
 	"reflect"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -26,7 +34,9 @@ import (
 	"github.com/digitalocean/doctl/commands/displayers"
 	"github.com/digitalocean/doctl/do"
 	"github.com/digitalocean/godo"
+	"github.com/digitalocean/godo/metrics"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 var (
@@ -55,7 +65,7 @@ With the load-balancer command, you can list, create, or delete load balancers,
 		aliasOpt("g"), displayerType(&displayers.LoadBalancer{}))
 
 	cmdLoadBalancerCreate := CmdBuilder(cmd, RunLoadBalancerCreate, "create",
-		"Create a new load balancer", "Use this command to create a new load balancer on your account. Valid forwarding rules are:\n"+forwardingDetail, Writer, aliasOpt("c"))
+		"Create a new load balancer", "Use this command to create a new load balancer on your account. Valid forwarding rules are:\n"+forwardingDetail, Writer, aliasOpt("c"), displayerType(&displayers.LoadBalancer{}))
 	AddStringFlag(cmdLoadBalancerCreate, doctl.ArgLoadBalancerName, "", "",
 		"The load balancer's name", requiredOpt())
 	AddStringFlag(cmdLoadBalancerCreate, doctl.ArgRegionSlug, "", "",
@@ -100,10 +110,11 @@ With the load-balancer command, you can list, create, or delete load balancers,
 		"CDN cache settings global load balancer, e.g.: `is_enabled:true` ")
 	AddStringSliceFlag(cmdLoadBalancerCreate, doctl.ArgTargetLoadBalancerIDs, "", []string{},
 		"A comma-separated list of Load Balancer IDs to add as target to the global load balancer ")
-	AddStringFlag(cmdLoadBalancerCreate, doctl.ArgLoadBalancerNetwork, "", "", "The type of network the load balancer is accessible from, e.g.: `EXTERNAL` or `INTERNAL`")
+	AddStringFlag(cmdLoadBalancerCreate, doctl.ArgLoadBalancerNetwork, "", "", "The type of network the load balancer is accessible from, e.g.: `EXTERNAL` or `INTERNAL`. `INTERNAL` load balancers are only reachable from within their VPC and require the `--vpc-uuid` flag.")
 	AddStringFlag(cmdLoadBalancerCreate, doctl.ArgLoadBalancerNetworkStack, "", "", "The network stack type determines the allocation of ipv4/ipv6 addresses to the load balancer, e.g.: `IPV4` or `DUALSTACK`"+
 		" (NOTE: this feature is in private preview, contact DigitalOcean support to review its public availability.)")
 	AddStringFlag(cmdLoadBalancerCreate, doctl.ArgLoadBalancerTLSCipherPolicy, "", "", "The tls cipher policy to be used for the load balancer, e.g.: `DEFAULT` or `STRONG`")
+	addTerraformImportFlag(cmdLoadBalancerCreate)
 
 	cmdRecordUpdate := CmdBuilder(cmd, RunLoadBalancerUpdate, "update <load-balancer-id>",
 		"Update a load balancer's configuration", `Use this command to update the configuration of a specified load balancer. Using all applicable flags, the command should contain a full representation of the load balancer including existing attributes, such as the load balancer's name, region, forwarding rules, and Droplet IDs. Any attribute that is not provided is reset to its default value.`, Writer, aliasOpt("u"))
@@ -149,9 +160,17 @@ With the load-balancer command, you can list, create, or delete load balancers,
 	AddStringSliceFlag(cmdRecordUpdate, doctl.ArgTargetLoadBalancerIDs, "", []string{},
 		"A comma-separated list of Load Balancer IDs to add as target to the global load balancer ")
 	AddStringFlag(cmdRecordUpdate, doctl.ArgLoadBalancerTLSCipherPolicy, "", "", "The tls cipher policy to be used for the load balancer, e.g.: `DEFAULT` or `STRONG`")
+	AddStringFlag(cmdRecordUpdate, doctl.ArgLoadBalancerPatchFile, "", "",
+		"Path to a JSON file containing a partial load balancer configuration. The patch is applied to the load balancer's current configuration using JSON merge patch semantics (RFC 7396). Cannot be combined with the other configuration flags.")
+	cmdRecordUpdate.Example = `The following command changes only the name of the load balancer with the ID ` + "`" + `cde2c0d6-41e3-479e-ba60-ad971227232c` + "`" + `, keeping the rest of its configuration: echo '{"name": "example-lb-01"}' > patch.json && doctl compute load-balancer update cde2c0d6-41e3-479e-ba60-ad971227232c --patch-file patch.json`
 
-	CmdBuilder(cmd, RunLoadBalancerList, "list", "List load balancers", "Use this command to get a list of the load balancers on your account, including the following information for each:\n\n"+lbDetail, Writer,
+	cmdLoadBalancerList := CmdBuilder(cmd, RunLoadBalancerList, "list", "List load balancers", "Use this command to get a list of the load balancers on your account, including the following information for each:\n\n"+lbDetail, Writer,
 		aliasOpt("ls"), displayerType(&displayers.LoadBalancer{}))
+	AddBoolFlag(cmdLoadBalancerList, doctl.ArgLoadBalancerGroupByRegion, "", false,
+		"Group the load balancers by region, printing a header before each region's table. Global load balancers are listed under `global`.")
+	AddBoolFlag(cmdLoadBalancerList, doctl.ArgLoadBalancerCheckHealth, "", false,
+		"Add a `HealthStatus` column summarizing each load balancer's status and health check configuration, and print a warning for each load balancer in an errored state")
+	AddStringFlag(cmdLoadBalancerList, doctl.ArgVPCUUID, "", "", "Lists only the load balancers in the VPC with the given UUID")
 
 	cmdRunRecordDelete := CmdBuilder(cmd, RunLoadBalancerDelete, "delete <load-balancer-id>",
 		"Permanently delete a load balancer", `Use this command to permanently delete the specified load balancer. This is irreversible.`, Writer, aliasOpt("d", "rm"))
@@ -181,9 +200,561 @@ With the load-balancer command, you can list, create, or delete load balancers,
 	AddBoolFlag(cmdRunCachePurge, doctl.ArgForce, doctl.ArgShortForce, false,
 		"Purge the global load balancer CDN cache without a confirmation prompt ")
 
+	cmdRequestStats := CmdBuilder(cmd, RunLoadBalancerRequestStats, "request-stats <load-balancer-id>",
+		"Show load balancer traffic statistics", `Use this command to show HTTP traffic statistics for a load balancer over a time range. The following columns are displayed for each sample:
+
+- `+"`"+`RequestsPerSecond`+"`"+`: The number of HTTP requests per second received by the load balancer
+- `+"`"+`ResponseTimeAvg`+"`"+`: The average time, in seconds, the backend Droplets took to respond
+- `+"`"+`ResponseTime95P`+"`"+`: The 95th percentile of the backend Droplets' response times, in seconds
+- `+"`"+`ErrorRate`+"`"+`: The percentage of responses with a 5xx status code
+
+Use the `+"`"+`--resolution`+"`"+` flag to average the samples into per-minute or per-hour buckets, and `+"`"+`--output json`+"`"+` to feed them into dashboarding tools.`, Writer,
+		displayerType(&displayers.Metrics{}))
+	addMetricsTimeRangeFlags(cmdRequestStats)
+	addMetricsResolutionFlag(cmdRequestStats)
+	cmdRequestStats.Example = `The following example shows the hourly traffic statistics of a load balancer with the ID ` + "`" + `f81d4fae-7dec-11d0-a765-00a0c91e6bf6` + "`" + ` for January 1st, 2026: doctl compute load-balancer request-stats f81d4fae-7dec-11d0-a765-00a0c91e6bf6 --start 2026-01-01T00:00:00Z --end 2026-01-02T00:00:00Z --resolution 1h`
+
+	cmd.AddCommand(loadBalancerHealthCheck())
+	cmd.AddCommand(loadBalancerAlgorithms())
+	cmd.AddCommand(loadBalancerHTTP2())
+	cmd.AddCommand(loadBalancerForwardingRules())
+	cmd.AddCommand(loadBalancerStickySessions())
+
+	return cmd
+}
+
+func loadBalancerForwardingRules() *Command {
+	cmd := &Command{
+		Command: &cobra.Command{
+			Use:   "forwarding-rules",
+			Short: "Display commands to view load balancer forwarding rules",
+			Long:  "The subcommands of `doctl compute load-balancer forwarding-rules` display the forwarding rules of a load balancer. Use `doctl compute load-balancer add-forwarding-rules` and `doctl compute load-balancer remove-forwarding-rules` to change them.",
+		},
+	}
+
+	cmdForwardingRulesList := CmdBuilder(cmd, RunLoadBalancerForwardingRulesList, "list <load-balancer-id>", "List a load balancer's forwarding rules", `Use this command to list the forwarding rules of a load balancer, including the following information for each:
+
+- The entry protocol and port on the load balancer
+- The target protocol and port on the backend Droplets
+- The ID of the SSL certificate used to terminate TLS, if any
+- Whether TLS traffic is passed through to the backend Droplets`, Writer,
+		aliasOpt("ls"), displayerType(&displayers.ForwardingRule{}))
+	cmdForwardingRulesList.Example = `The following example lists the forwarding rules of the load balancer with the ID ` + "`" + `cde2c0d6-41e3-479e-ba60-ad971227232c` + "`" + `: doctl compute load-balancer forwarding-rules list cde2c0d6-41e3-479e-ba60-ad971227232c`
+
+	return cmd
+}
+
+func loadBalancerStickySessions() *Command {
+	cmd := &Command{
+		Command: &cobra.Command{
+			Use:     "sticky-sessions",
+			Aliases: []string{"stickysessions"},
+			Short:   "Display commands to manage load balancer sticky sessions",
+			Long:    "The subcommands of `doctl compute load-balancer sticky-sessions` view and change the sticky sessions settings of a load balancer. When sticky sessions are enabled, the load balancer sets a cookie so that the requests of a client are sent to the same backend Droplet.",
+		},
+	}
+
+	cmdStickySessionsGet := CmdBuilder(cmd, RunLoadBalancerStickySessionsGet, "get <load-balancer-id>", "Retrieve a load balancer's sticky sessions settings", `Use this command to retrieve the sticky sessions settings of a load balancer, including the sticky sessions type and, for `+"`"+`cookies`+"`"+`, the cookie name and lifetime.`, Writer,
+		aliasOpt("g"), displayerType(&displayers.StickySessions{}))
+	cmdStickySessionsGet.Example = `The following example retrieves the sticky sessions settings of the load balancer with the ID ` + "`" + `cde2c0d6-41e3-479e-ba60-ad971227232c` + "`" + `: doctl compute load-balancer sticky-sessions get cde2c0d6-41e3-479e-ba60-ad971227232c`
+
+	cmdStickySessionsSet := CmdBuilder(cmd, RunLoadBalancerStickySessionsSet, "set <load-balancer-id>", "Configure a load balancer's sticky sessions", `Use this command to enable sticky sessions on a load balancer or change their settings. The other settings of the load balancer are left unchanged.`, Writer,
+		displayerType(&displayers.StickySessions{}))
+	AddStringFlag(cmdStickySessionsSet, doctl.ArgStickySessionsType, "", "cookies", "The sticky sessions type. Possible values: `cookies`")
+	AddStringFlag(cmdStickySessionsSet, doctl.ArgStickySessionsCookieName, "", "", "The name of the cookie sent to the client", requiredOpt())
+	AddIntFlag(cmdStickySessionsSet, doctl.ArgStickySessionsCookieTTL, "", 300, "The number of seconds until the cookie expires")
+	cmdStickySessionsSet.Example = `The following example enables cookie-based sticky sessions with a one-hour cookie on the load balancer with the ID ` + "`" + `cde2c0d6-41e3-479e-ba60-ad971227232c` + "`" + `: doctl compute load-balancer sticky-sessions set cde2c0d6-41e3-479e-ba60-ad971227232c --cookie-name DO-LB --cookie-ttl-seconds 3600`
+
+	cmdStickySessionsDisable := CmdBuilder(cmd, RunLoadBalancerStickySessionsDisable, "disable <load-balancer-id>", "Disable a load balancer's sticky sessions", `Use this command to disable sticky sessions on a load balancer by setting their type to `+"`"+`none`+"`"+`.`, Writer,
+		displayerType(&displayers.StickySessions{}))
+	cmdStickySessionsDisable.Example = `The following example disables sticky sessions on the load balancer with the ID ` + "`" + `cde2c0d6-41e3-479e-ba60-ad971227232c` + "`" + `: doctl compute load-balancer sticky-sessions disable cde2c0d6-41e3-479e-ba60-ad971227232c`
+
+	return cmd
+}
+
+func loadBalancerHealthCheck() *Command {
+	cmd := &Command{
+		Command: &cobra.Command{
+			Use:   "health-check",
+			Short: "Display commands to troubleshoot load balancer health checks",
+			Long:  "The subcommands of `doctl compute load-balancer health-check` help you verify the health check configuration of your load balancers.",
+		},
+	}
+
+	cmdHealthCheckGet := CmdBuilder(cmd, RunLoadBalancerHealthCheckGet, "get <load-balancer-id>",
+		"Retrieve a load balancer's health check settings", `Use this command to retrieve only the health check settings of a load balancer, including:
+
+- The protocol, port, and path used to check backend Droplets
+- The number of seconds between checks and the number of seconds to wait for a response
+- The number of consecutive checks needed to mark a Droplet healthy or unhealthy
+- Whether the PROXY protocol is enabled`, Writer,
+		aliasOpt("g"), displayerType(&displayers.HealthCheck{}))
+	cmdHealthCheckGet.Example = `The following example retrieves the health check settings of the load balancer with the ID ` + "`" + `cde2c0d6-41e3-479e-ba60-ad971227232c` + "`" + `: doctl compute load-balancer health-check get cde2c0d6-41e3-479e-ba60-ad971227232c`
+
+	cmdHealthCheckTest := CmdBuilder(cmd, RunLoadBalancerHealthCheckTest, "test <load-balancer-id>",
+		"Run a load balancer's health check against a backend Droplet", `Use this command to run the health check configured on a load balancer against one of its backend Droplets from your machine.
+
+The request is made to the Droplet's public IPv4 address using the protocol, port, path, and response timeout of the load balancer's health check. The response code and body are printed. For `+"`"+`tcp`+"`"+` health checks, only the connection is tested.
+
+If the check succeeds from your machine while the load balancer reports the Droplet as unhealthy, a firewall is likely blocking traffic from the load balancer to the Droplet.`, Writer)
+	AddStringFlag(cmdHealthCheckTest, doctl.ArgLoadBalancerBackend, "", "", "The ID of the backend Droplet to test", requiredOpt())
+	cmdHealthCheckTest.Example = `The following example runs the health check of the load balancer with the ID ` + "`" + `cde2c0d6-41e3-479e-ba60-ad971227232c` + "`" + ` against the Droplet with the ID ` + "`" + `386734086` + "`" + `: doctl compute load-balancer health-check test cde2c0d6-41e3-479e-ba60-ad971227232c --backend 386734086`
+
+	return cmd
+}
+
+// validLoadBalancerAlgorithms lists the values accepted by the load balancer
+// `--algorithm` flag.
+var validLoadBalancerAlgorithms = []displayers.LoadBalancerAlgorithmInfo{
+	{Name: "round_robin", Description: "Sends requests to each backend Droplet in turn"},
+	{Name: "least_connections", Description: "Sends requests to the backend Droplet with the fewest active connections"},
+}
+
+func loadBalancerAlgorithms() *Command {
+	cmd := &Command{
+		Command: &cobra.Command{
+			Use:   "algorithms",
+			Short: "Display commands to list load balancing algorithms",
+			Long:  "The subcommands of `doctl compute load-balancer algorithms` display the load balancing algorithms accepted by the `--algorithm` flag.",
+		},
+	}
+
+	CmdBuilder(cmd, RunLoadBalancerAlgorithmsList, "list", "List the available load balancing algorithms", `Use this command to list the load balancing algorithms accepted by the `+"`"+`--algorithm`+"`"+` flag of `+"`"+`doctl compute load-balancer create`+"`"+` and `+"`"+`doctl compute load-balancer update`+"`"+`.
+
+The algorithm setting has been deprecated and is ignored by new load balancers, but an invalid value is still rejected.`, Writer,
+		aliasOpt("ls"), displayerType(&displayers.LoadBalancerAlgorithm{}))
+
+	return cmd
+}
+
+func loadBalancerHTTP2() *Command {
+	cmd := &Command{
+		Command: &cobra.Command{
+			Use:   "http2",
+			Short: "Display commands to enable or disable HTTP/2 on a load balancer",
+			Long: `The subcommands of ` + "`" + `doctl compute load-balancer http2` + "`" + ` switch a load balancer's HTTPS forwarding rules between HTTPS and HTTP/2.
+
+HTTP/2 is only available on forwarding rules that terminate TLS at the load balancer, so each rule needs an SSL certificate, set with ` + "`" + `certificate_id` + "`" + ` in ` + "`" + `--forwarding-rules` + "`" + `. Backends keep receiving the target protocol configured on each rule.`,
+		},
+	}
+
+	cmdHTTP2Enable := CmdBuilder(cmd, RunLoadBalancerHTTP2Enable, "enable <load-balancer-id>", "Enable HTTP/2 on a load balancer", `Use this command to change the entry protocol of every `+"`"+`https`+"`"+` forwarding rule on a load balancer to `+"`"+`http2`+"`"+`. Rules that use TLS passthrough are skipped, because HTTP/2 requires the load balancer to terminate TLS with a certificate.`, Writer,
+		displayerType(&displayers.LoadBalancer{}))
+	cmdHTTP2Enable.Example = `The following example enables HTTP/2 on the load balancer with the ID ` + "`" + `cde2c0d6-41e3-479e-ba60-ad971227232c` + "`" + `: doctl compute load-balancer http2 enable cde2c0d6-41e3-479e-ba60-ad971227232c`
+
+	cmdHTTP2Disable := CmdBuilder(cmd, RunLoadBalancerHTTP2Disable, "disable <load-balancer-id>", "Disable HTTP/2 on a load balancer", `Use this command to change the entry protocol of every `+"`"+`http2`+"`"+` forwarding rule on a load balancer back to `+"`"+`https`+"`"+`.`, Writer,
+		displayerType(&displayers.LoadBalancer{}))
+	cmdHTTP2Disable.Example = `The following example disables HTTP/2 on the load balancer with the ID ` + "`" + `cde2c0d6-41e3-479e-ba60-ad971227232c` + "`" + `: doctl compute load-balancer http2 disable cde2c0d6-41e3-479e-ba60-ad971227232c`
+
 	return cmd
 }
 
+// RunLoadBalancerForwardingRulesList lists the forwarding rules of a load
+// balancer.
+func RunLoadBalancerForwardingRulesList(c *CmdConfig) error {
+	err := ensureOneArg(c)
+	if err != nil {
+		return err
+	}
+
+	lb, err := c.LoadBalancers().Get(c.Args[0])
+	if err != nil {
+		return err
+	}
+
+	return c.Display(&displayers.ForwardingRule{ForwardingRules: lb.ForwardingRules})
+}
+
+// RunLoadBalancerHTTP2Enable switches a load balancer's HTTPS forwarding
+// rules to HTTP/2.
+func RunLoadBalancerHTTP2Enable(c *CmdConfig) error {
+	return setLoadBalancerEntryProtocol(c, "https", "http2")
+}
+
+// RunLoadBalancerHTTP2Disable switches a load balancer's HTTP/2 forwarding
+// rules back to HTTPS.
+func RunLoadBalancerHTTP2Disable(c *CmdConfig) error {
+	return setLoadBalancerEntryProtocol(c, "http2", "https")
+}
+
+// setLoadBalancerEntryProtocol changes the entry protocol of every forwarding
+// rule using from to to, and updates the load balancer if any rule changed.
+func setLoadBalancerEntryProtocol(c *CmdConfig, from, to string) error {
+	err := ensureOneArg(c)
+	if err != nil {
+		return err
+	}
+
+	lbs := c.LoadBalancers()
+	lb, err := lbs.Get(c.Args[0])
+	if err != nil {
+		return err
+	}
+
+	var changed, current, passthrough int
+	for i, rule := range lb.ForwardingRules {
+		switch rule.EntryProtocol {
+		case from:
+			// HTTP/2 needs the load balancer to terminate TLS with a
+			// certificate, so rules that pass TLS through are left alone.
+			if to == "http2" && rule.TlsPassthrough {
+				warn("Skipping the forwarding rule on port %d because it uses TLS passthrough, which does not support http2", rule.EntryPort)
+				passthrough++
+				continue
+			}
+			lb.ForwardingRules[i].EntryProtocol = to
+			changed++
+		case to:
+			current++
+		}
+	}
+
+	if changed == 0 {
+		if current == 0 {
+			if passthrough > 0 {
+				return fmt.Errorf("load balancer %s has no %s forwarding rules without TLS passthrough", lb.ID, from)
+			}
+			return fmt.Errorf("load balancer %s has no %s forwarding rules", lb.ID, from)
+		}
+		notice("The forwarding rules of load balancer %s already use %s", lb.ID, to)
+		return c.Display(&displayers.LoadBalancer{LoadBalancers: do.LoadBalancers{*lb}})
+	}
+
+	updated, err := lbs.Update(lb.ID, lb.AsRequest())
+	if err != nil {
+		return err
+	}
+
+	return c.Display(&displayers.LoadBalancer{LoadBalancers: do.LoadBalancers{*updated}})
+}
+
+// RunLoadBalancerAlgorithmsList lists the available load balancing algorithms.
+func RunLoadBalancerAlgorithmsList(c *CmdConfig) error {
+	return c.Display(&displayers.LoadBalancerAlgorithm{Algorithms: validLoadBalancerAlgorithms})
+}
+
+// RunLoadBalancerStickySessionsGet retrieves the sticky sessions settings of a
+// load balancer.
+func RunLoadBalancerStickySessionsGet(c *CmdConfig) error {
+	err := ensureOneArg(c)
+	if err != nil {
+		return err
+	}
+
+	lb, err := c.LoadBalancers().Get(c.Args[0])
+	if err != nil {
+		return err
+	}
+
+	return c.Display(&displayers.StickySessions{StickySessions: lb.StickySessions})
+}
+
+// RunLoadBalancerStickySessionsSet configures the sticky sessions of a load
+// balancer.
+func RunLoadBalancerStickySessionsSet(c *CmdConfig) error {
+	err := ensureOneArg(c)
+	if err != nil {
+		return err
+	}
+
+	ssType, err := c.Doit.GetString(c.NS, doctl.ArgStickySessionsType)
+	if err != nil {
+		return err
+	}
+	if ssType != "cookies" {
+		return fmt.Errorf("invalid value %q for --%s; possible values: cookies. Use `doctl compute load-balancer sticky-sessions disable` to turn sticky sessions off", ssType, doctl.ArgStickySessionsType)
+	}
+
+	cookieName, err := c.Doit.GetString(c.NS, doctl.ArgStickySessionsCookieName)
+	if err != nil {
+		return err
+	}
+
+	cookieTTL, err := c.Doit.GetInt(c.NS, doctl.ArgStickySessionsCookieTTL)
+	if err != nil {
+		return err
+	}
+
+	return setLoadBalancerStickySessions(c, &godo.StickySessions{
+		Type:             ssType,
+		CookieName:       cookieName,
+		CookieTtlSeconds: cookieTTL,
+	})
+}
+
+// RunLoadBalancerStickySessionsDisable disables the sticky sessions of a load
+// balancer.
+func RunLoadBalancerStickySessionsDisable(c *CmdConfig) error {
+	err := ensureOneArg(c)
+	if err != nil {
+		return err
+	}
+
+	return setLoadBalancerStickySessions(c, &godo.StickySessions{Type: "none"})
+}
+
+// setLoadBalancerStickySessions replaces the sticky sessions settings of the
+// load balancer given as the first argument, keeping its other settings.
+func setLoadBalancerStickySessions(c *CmdConfig, ss *godo.StickySessions) error {
+	lbs := c.LoadBalancers()
+	lb, err := lbs.Get(c.Args[0])
+	if err != nil {
+		return err
+	}
+
+	lb.StickySessions = ss
+	updated, err := lbs.Update(lb.ID, lb.AsRequest())
+	if err != nil {
+		return err
+	}
+
+	return c.Display(&displayers.StickySessions{StickySessions: updated.StickySessions})
+}
+
+// RunLoadBalancerHealthCheckGet retrieves the health check settings of a load
+// balancer.
+func RunLoadBalancerHealthCheckGet(c *CmdConfig) error {
+	err := ensureOneArg(c)
+	if err != nil {
+		return err
+	}
+	lbID := c.Args[0]
+
+	lb, err := c.LoadBalancers().Get(lbID)
+	if err != nil {
+		return err
+	}
+	if lb.HealthCheck == nil {
+		return fmt.Errorf("load balancer %s has no health check configured", lbID)
+	}
+
+	return c.Display(&displayers.HealthCheck{HealthCheck: lb.HealthCheck})
+}
+
+// RunLoadBalancerHealthCheckTest runs a load balancer's health check against
+// one of its backend Droplets from the local machine.
+func RunLoadBalancerHealthCheckTest(c *CmdConfig) error {
+	err := ensureOneArg(c)
+	if err != nil {
+		return err
+	}
+	lbID := c.Args[0]
+
+	backend, err := c.Doit.GetString(c.NS, doctl.ArgLoadBalancerBackend)
+	if err != nil {
+		return err
+	}
+	dropletID, err := strconv.Atoi(backend)
+	if err != nil {
+		return fmt.Errorf("invalid Droplet ID %q for --%s", backend, doctl.ArgLoadBalancerBackend)
+	}
+
+	lb, err := c.LoadBalancers().Get(lbID)
+	if err != nil {
+		return err
+	}
+	if lb.HealthCheck == nil {
+		return fmt.Errorf("load balancer %s has no health check configured", lbID)
+	}
+
+	droplet, err := c.Droplets().Get(dropletID)
+	if err != nil {
+		return err
+	}
+	ip, err := droplet.PublicIPv4()
+	if err != nil {
+		return err
+	}
+	if ip == "" {
+		return fmt.Errorf("Droplet %d has no public IPv4 address to test", dropletID)
+	}
+
+	if !isLoadBalancerBackend(lb, droplet) {
+		warn("Droplet %d is not a backend of load balancer %s", dropletID, lbID)
+	}
+
+	hc := lb.HealthCheck
+	timeout := time.Duration(hc.ResponseTimeoutSeconds) * time.Second
+	if timeout == 0 {
+		timeout = 5 * time.Second
+	}
+	addr := net.JoinHostPort(ip, strconv.Itoa(hc.Port))
+
+	var checkErr error
+	switch strings.ToLower(hc.Protocol) {
+	case "tcp":
+		checkErr = testTCPHealthCheck(c.Out, addr, timeout)
+	case "http", "https":
+		checkErr = testHTTPHealthCheck(c.Out, strings.ToLower(hc.Protocol)+"://"+addr+hc.Path, timeout)
+	default:
+		return fmt.Errorf("unsupported health check protocol %q", hc.Protocol)
+	}
+	if checkErr != nil {
+		return checkErr
+	}
+
+	if loadBalancerReportsUnhealthy(c.Monitoring(), lbID, dropletID) {
+		warn("The health check succeeded from this machine, but the load balancer reports Droplet %d as unhealthy. Check that the Droplet's firewall allows traffic from the load balancer.", dropletID)
+	}
+
+	return nil
+}
+
+func isLoadBalancerBackend(lb *do.LoadBalancer, droplet *do.Droplet) bool {
+	if lb.Tag != "" {
+		return slices.Contains(droplet.Tags, lb.Tag)
+	}
+
+	return slices.Contains(lb.DropletIDs, droplet.ID)
+}
+
+func testTCPHealthCheck(out io.Writer, addr string, timeout time.Duration) error {
+	conn, err := net.DialTimeout("tcp", addr, timeout)
+	if err != nil {
+		return fmt.Errorf("health check failed: %v", err)
+	}
+	conn.Close()
+
+	fmt.Fprintf(out, "Connection to %s succeeded\n", addr)
+	return nil
+}
+
+func testHTTPHealthCheck(out io.Writer, url string, timeout time.Duration) error {
+	client := &http.Client{
+		Timeout: timeout,
+		// Load balancer health checks do not follow redirects.
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+		Transport: &http.Transport{
+			// Backends commonly serve certificates that are not valid for their IP address.
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		},
+	}
+
+	resp, err := client.Get(url)
+	if err != nil {
+		return fmt.Errorf("health check failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "GET %s\nResponse code: %d\nResponse body:\n%s\n", url, resp.StatusCode, body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("health check failed: expected a 2xx response code but got %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// loadBalancerReportsUnhealthy reports whether the most recent health check
+// status recorded by the load balancer for the given Droplet is unhealthy.
+// Monitoring errors are ignored as the status is only used to print a hint.
+func loadBalancerReportsUnhealthy(ms do.MonitoringService, lbID string, dropletID int) bool {
+	end := time.Now()
+	resp, err := ms.GetLoadBalancerDropletsHealthChecks(lbID, end.Add(-5*time.Minute), end)
+	if err != nil || resp == nil {
+		return false
+	}
+
+	id := strconv.Itoa(dropletID)
+	for _, stream := range resp.Data.Result {
+		if string(stream.Metric["droplet_id"]) != id || len(stream.Values) == 0 {
+			continue
+		}
+
+		return stream.Values[len(stream.Values)-1].Value == 0
+	}
+
+	return false
+}
+
+// RunLoadBalancerRequestStats shows the HTTP request rate, response times,
+// and error rate of a load balancer over a time range.
+func RunLoadBalancerRequestStats(c *CmdConfig) error {
+	err := ensureOneArg(c)
+	if err != nil {
+		return err
+	}
+	lbID := c.Args[0]
+
+	start, end, err := metricsTimeRange(c)
+	if err != nil {
+		return err
+	}
+
+	resolution, err := c.Doit.GetDuration(c.NS, doctl.ArgMetricsResolution)
+	if err != nil {
+		return err
+	}
+
+	ms := c.Monitoring()
+	requests, err := ms.GetLoadBalancerFrontendHttpRequestsPerSecond(lbID, start, end)
+	if err != nil {
+		return err
+	}
+	responseTimeAvg, err := ms.GetLoadBalancerDropletsHttpResponseTimeAvg(lbID, start, end)
+	if err != nil {
+		return err
+	}
+	responseTime95P, err := ms.GetLoadBalancerDropletsHttpResponseTime95P(lbID, start, end)
+	if err != nil {
+		return err
+	}
+	responses, err := ms.GetLoadBalancerFrontendHttpResponses(lbID, start, end)
+	if err != nil {
+		return err
+	}
+
+	points := metricPoints(map[string][]metrics.SamplePair{
+		"RequestsPerSecond": sumMetricStreams(requests.Data.Result),
+		"ResponseTimeAvg":   meanMetricStreams(responseTimeAvg.Data.Result),
+		"ResponseTime95P":   meanMetricStreams(responseTime95P.Data.Result),
+		"ErrorRate":         loadBalancerErrorRate(responses.Data.Result),
+	})
+
+	item := &displayers.Metrics{
+		Series: []string{"RequestsPerSecond", "ResponseTimeAvg", "ResponseTime95P", "ErrorRate"},
+		Points: resampleMetricPoints(points, resolution),
+	}
+	return c.Display(item)
+}
+
+// loadBalancerErrorRate returns the percentage of responses with a 5xx status
+// code. The HTTP responses metric has one stream per status class, labeled
+// with values such as "2xx" and "5xx".
+func loadBalancerErrorRate(streams []metrics.SampleStream) []metrics.SamplePair {
+	var errorStreams []metrics.SampleStream
+	for _, stream := range streams {
+		for _, v := range stream.Metric {
+			if v == "5xx" {
+				errorStreams = append(errorStreams, stream)
+				break
+			}
+		}
+	}
+
+	failed := make(map[metrics.Time]metrics.SampleValue)
+	for _, s := range sumMetricStreams(errorStreams) {
+		failed[s.Timestamp] = s.Value
+	}
+
+	var rate []metrics.SamplePair
+	for _, s := range sumMetricStreams(streams) {
+		if s.Value == 0 {
+			continue
+		}
+		rate = append(rate, metrics.SamplePair{Timestamp: s.Timestamp, Value: 100 * failed[s.Timestamp] / s.Value})
+	}
+
+	return rate
+}
+
 // RunLoadBalancerGet retrieves an existing load balancer by its identifier.
 func RunLoadBalancerGet(c *CmdConfig) error {
 	err := ensureOneArg(c)
@@ -204,14 +775,101 @@ func RunLoadBalancerGet(c *CmdConfig) error {
 
 // RunLoadBalancerList lists load balancers.
 func RunLoadBalancerList(c *CmdConfig) error {
+	vpcUUID, err := c.Doit.GetString(c.NS, doctl.ArgVPCUUID)
+	if err != nil {
+		return err
+	}
+
 	lbs := c.LoadBalancers()
 	list, err := lbs.List()
 	if err != nil {
 		return err
 	}
 
-	item := &displayers.LoadBalancer{LoadBalancers: list}
-	return c.Display(item)
+	if vpcUUID != "" {
+		filtered := make(do.LoadBalancers, 0, len(list))
+		for _, lb := range list {
+			if lb.VPCUUID == vpcUUID {
+				filtered = append(filtered, lb)
+			}
+		}
+		list = filtered
+	}
+
+	groupByRegion, err := c.Doit.GetBool(c.NS, doctl.ArgLoadBalancerGroupByRegion)
+	if err != nil {
+		return err
+	}
+	checkHealth, err := c.Doit.GetBool(c.NS, doctl.ArgLoadBalancerCheckHealth)
+	if err != nil {
+		return err
+	}
+
+	var healthStatus map[string]string
+	if checkHealth {
+		healthStatus = make(map[string]string, len(list))
+		for _, lb := range list {
+			healthStatus[lb.ID] = loadBalancerHealthStatus(lb)
+			if lb.Status == "errored" {
+				warn("Load balancer %s (%s) is in an errored state", lb.Name, lb.ID)
+			}
+		}
+	}
+
+	if !groupByRegion {
+		item := &displayers.LoadBalancer{LoadBalancers: list, HealthStatus: healthStatus}
+		return c.Display(item)
+	}
+
+	sort.SliceStable(list, func(i, j int) bool {
+		return loadBalancerRegion(list[i]) < loadBalancerRegion(list[j])
+	})
+	// Only text output is split into groups; other output types, including
+	// unsupported ones, are left to the displayer.
+	if Output != "text" {
+		return c.Display(&displayers.LoadBalancer{LoadBalancers: list, HealthStatus: healthStatus})
+	}
+
+	for i := 0; i < len(list); {
+		region := loadBalancerRegion(list[i])
+		j := i
+		for j < len(list) && loadBalancerRegion(list[j]) == region {
+			j++
+		}
+
+		if i > 0 {
+			fmt.Fprintln(c.Out)
+		}
+		fmt.Fprintf(c.Out, "Region: %s\n", region)
+		if err := c.Display(&displayers.LoadBalancer{LoadBalancers: list[i:j], HealthStatus: healthStatus}); err != nil {
+			return err
+		}
+		i = j
+	}
+
+	return nil
+}
+
+// loadBalancerHealthStatus summarizes the health of a load balancer from its
+// status and whether it has a health check configured.
+func loadBalancerHealthStatus(lb do.LoadBalancer) string {
+	switch {
+	case lb.Status != "active":
+		return lb.Status
+	case lb.HealthCheck == nil:
+		return "no health check"
+	default:
+		return "healthy"
+	}
+}
+
+// loadBalancerRegion returns the region slug of a load balancer, or "global"
+// for load balancers that are not bound to a region.
+func loadBalancerRegion(lb do.LoadBalancer) string {
+	if lb.Region == nil || lb.Region.Slug == "" {
+		return "global"
+	}
+	return lb.Region.Slug
 }
 
 // RunLoadBalancerCreate creates a new load balancer with a given configuration.
@@ -221,6 +879,10 @@ func RunLoadBalancerCreate(c *CmdConfig) error {
 		return err
 	}
 
+	if r.Network == godo.LoadBalancerNetworkTypeInternal && r.VPCUUID == "" {
+		return fmt.Errorf("the --%s flag is required when creating an %s load balancer", doctl.ArgVPCUUID, godo.LoadBalancerNetworkTypeInternal)
+	}
+
 	lbs := c.LoadBalancers()
 	lb, err := lbs.Create(r)
 	if err != nil {
@@ -249,6 +911,14 @@ func RunLoadBalancerCreate(c *CmdConfig) error {
 
 	notice("Load balancer created")
 
+	tfImport, err := c.Doit.GetBool(c.NS, doctl.ArgTerraformImport)
+	if err != nil {
+		return err
+	}
+	if tfImport {
+		printTerraformImport("digitalocean_loadbalancer", lb.Name, lb.ID)
+	}
+
 	item := &displayers.LoadBalancer{LoadBalancers: do.LoadBalancers{*lb}}
 	return c.Display(item)
 }
@@ -260,12 +930,26 @@ func RunLoadBalancerUpdate(c *CmdConfig) error {
 	}
 	lbID := c.Args[0]
 
-	r := new(godo.LoadBalancerRequest)
-	if err := buildRequestFromArgs(c, r); err != nil {
+	patchFile, err := c.Doit.GetString(c.NS, doctl.ArgLoadBalancerPatchFile)
+	if err != nil {
 		return err
 	}
 
 	lbs := c.LoadBalancers()
+
+	r := new(godo.LoadBalancerRequest)
+	if patchFile != "" {
+		if name := changedConfigFlag(c.Command); name != "" {
+			return fmt.Errorf("the --%s flag cannot be combined with --%s; put the change in the patch file instead", name, doctl.ArgLoadBalancerPatchFile)
+		}
+		r, err = buildRequestFromPatchFile(lbs, lbID, patchFile)
+		if err != nil {
+			return err
+		}
+	} else if err := buildRequestFromArgs(c, r); err != nil {
+		return err
+	}
+
 	lb, err := lbs.Update(lbID, r)
 	if err != nil {
 		return err
@@ -275,6 +959,22 @@ func RunLoadBalancerUpdate(c *CmdConfig) error {
 	return c.Display(item)
 }
 
+// changedConfigFlag returns the name of the first configuration flag set on
+// the command besides --patch-file, or an empty string if there is none.
+func changedConfigFlag(cmd *cobra.Command) string {
+	var name string
+	cmd.LocalFlags().VisitAll(func(f *pflag.Flag) {
+		switch f.Name {
+		case doctl.ArgLoadBalancerPatchFile, doctl.ArgFormat, doctl.ArgNoHeader:
+			return
+		}
+		if f.Changed && name == "" {
+			name = f.Name
+		}
+	})
+	return name
+}
+
 // RunLoadBalancerDelete deletes a load balancer by its identifier.
 func RunLoadBalancerDelete(c *CmdConfig) error {
 	err := ensureOneArg(c)
@@ -541,6 +1241,11 @@ func buildRequestFromArgs(c *CmdConfig, r *godo.LoadBalancerRequest) error {
 	if err != nil {
 		return err
 	}
+	if algorithm != "" && !slices.ContainsFunc(validLoadBalancerAlgorithms, func(a displayers.LoadBalancerAlgorithmInfo) bool {
+		return a.Name == algorithm
+	}) {
+		return fmt.Errorf("invalid load balancer algorithm %q; run `doctl compute load-balancer algorithms list` to see the valid algorithms", algorithm)
+	}
 	r.Algorithm = algorithm
 
 	tag, err := c.Doit.GetString(c.NS, doctl.ArgTagName)
@@ -723,6 +1428,73 @@ func buildRequestFromArgs(c *CmdConfig, r *godo.LoadBalancerRequest) error {
 	return nil
 }
 
+// buildRequestFromPatchFile fetches the current configuration of a load
+// balancer and applies the JSON merge patch read from path to it.
+func buildRequestFromPatchFile(lbs do.LoadBalancersService, lbID string, path string) (*godo.LoadBalancerRequest, error) {
+	patchBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading patch file: %w", err)
+	}
+
+	var patch any
+	if err := json.Unmarshal(patchBytes, &patch); err != nil {
+		return nil, fmt.Errorf("parsing patch file: %w", err)
+	}
+	if _, ok := patch.(map[string]any); !ok {
+		return nil, errors.New("patch file must contain a JSON object")
+	}
+
+	lb, err := lbs.Get(lbID)
+	if err != nil {
+		return nil, err
+	}
+
+	currentBytes, err := json.Marshal(lb.AsRequest())
+	if err != nil {
+		return nil, err
+	}
+
+	var current any
+	if err := json.Unmarshal(currentBytes, &current); err != nil {
+		return nil, err
+	}
+
+	mergedBytes, err := json.Marshal(jsonMergePatch(current, patch))
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(godo.LoadBalancerRequest)
+	if err := json.Unmarshal(mergedBytes, r); err != nil {
+		return nil, fmt.Errorf("applying patch file: %w", err)
+	}
+
+	return r, nil
+}
+
+// jsonMergePatch applies patch to target following the semantics of RFC 7396.
+func jsonMergePatch(target, patch any) any {
+	patchObj, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+
+	targetObj, ok := target.(map[string]any)
+	if !ok {
+		targetObj = map[string]any{}
+	}
+
+	for k, v := range patchObj {
+		if v == nil {
+			delete(targetObj, k)
+			continue
+		}
+		targetObj[k] = jsonMergePatch(targetObj[k], v)
+	}
+
+	return targetObj
+}
+
 func waitForActiveLoadBalancer(lbs do.LoadBalancersService, lbID string) error {
 	const maxAttempts = 180
 	const wantStatus = "active"
@@ -1,13 +1,25 @@
 package commands
 
 import (
+	"bytes"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/do"
 	"github.com/digitalocean/godo"
+	"github.com/digitalocean/godo/metrics"
 
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 )
 
 var (
@@ -30,7 +42,172 @@ var (
 func TestLoadBalancerCommand(t *testing.T) {
 	cmd := LoadBalancer()
 	assert.NotNil(t, cmd)
-	assertCommandNames(t, cmd, "get", "list", "create", "update", "delete", "add-droplets", "remove-droplets", "add-forwarding-rules", "remove-forwarding-rules", "purge-cache")
+	assertCommandNames(t, cmd, "get", "list", "create", "update", "delete", "add-droplets", "remove-droplets", "add-forwarding-rules", "remove-forwarding-rules", "purge-cache", "request-stats", "health-check", "algorithms", "http2", "forwarding-rules", "sticky-sessions")
+}
+
+func TestLoadBalancerAlgorithmsList(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Doit.Set(config.NS, doctl.ArgFormat, "Name")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := RunLoadBalancerAlgorithmsList(config)
+		assert.NoError(t, err)
+		assert.Equal(t, "round_robin\nleast_connections\n", buf.String())
+	})
+}
+
+func TestLoadBalancerForwardingRulesList(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		lbID := "cde2c0d6-41e3-479e-ba60-ad971227232c"
+		lb := do.LoadBalancer{LoadBalancer: &godo.LoadBalancer{
+			ID: lbID,
+			ForwardingRules: []godo.ForwardingRule{
+				{EntryProtocol: "http", EntryPort: 80, TargetProtocol: "http", TargetPort: 80},
+				{EntryProtocol: "https", EntryPort: 443, TargetProtocol: "http", TargetPort: 8080, CertificateID: "cert"},
+			},
+		}}
+		tm.loadBalancers.EXPECT().Get(lbID).Return(&lb, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Args = append(config.Args, lbID)
+		config.Doit.Set(config.NS, doctl.ArgFormat, "EntryProtocol,EntryPort,TargetPort,CertificateID")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := RunLoadBalancerForwardingRulesList(config)
+		assert.NoError(t, err)
+		assert.Equal(t, "http     80     80      \nhttps    443    8080    cert\n", buf.String())
+	})
+}
+
+func TestLoadBalancerHTTP2Enable(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		lbID := "cde2c0d6-41e3-479e-ba60-ad971227232c"
+		lb := do.LoadBalancer{LoadBalancer: &godo.LoadBalancer{
+			ID: lbID,
+			ForwardingRules: []godo.ForwardingRule{
+				{EntryProtocol: "http", EntryPort: 80, TargetProtocol: "http", TargetPort: 80},
+				{EntryProtocol: "https", EntryPort: 443, TargetProtocol: "http", TargetPort: 80, CertificateID: "cert"},
+			},
+		}}
+		tm.loadBalancers.EXPECT().Get(lbID).Return(&lb, nil)
+		tm.loadBalancers.EXPECT().Update(lbID, gomock.Any()).DoAndReturn(func(_ string, r *godo.LoadBalancerRequest) (*do.LoadBalancer, error) {
+			assert.Equal(t, "http", r.ForwardingRules[0].EntryProtocol)
+			assert.Equal(t, "http2", r.ForwardingRules[1].EntryProtocol)
+			return &lb, nil
+		})
+
+		config.Args = append(config.Args, lbID)
+
+		err := RunLoadBalancerHTTP2Enable(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestLoadBalancerHTTP2EnableSkipsTLSPassthrough(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		lbID := "cde2c0d6-41e3-479e-ba60-ad971227232c"
+		lb := do.LoadBalancer{LoadBalancer: &godo.LoadBalancer{
+			ID: lbID,
+			ForwardingRules: []godo.ForwardingRule{
+				{EntryProtocol: "https", EntryPort: 443, TargetProtocol: "http", TargetPort: 80, CertificateID: "cert"},
+				{EntryProtocol: "https", EntryPort: 8443, TargetProtocol: "https", TargetPort: 443, TlsPassthrough: true},
+			},
+		}}
+		tm.loadBalancers.EXPECT().Get(lbID).Return(&lb, nil)
+		tm.loadBalancers.EXPECT().Update(lbID, gomock.Any()).DoAndReturn(func(_ string, r *godo.LoadBalancerRequest) (*do.LoadBalancer, error) {
+			assert.Equal(t, "http2", r.ForwardingRules[0].EntryProtocol)
+			assert.Equal(t, "https", r.ForwardingRules[1].EntryProtocol)
+			return &lb, nil
+		})
+
+		config.Args = append(config.Args, lbID)
+
+		var err error
+		warnings := captureStderr(t, func() { err = RunLoadBalancerHTTP2Enable(config) })
+		assert.NoError(t, err)
+		assert.Contains(t, warnings, "Skipping the forwarding rule on port 8443")
+	})
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		lbID := "cde2c0d6-41e3-479e-ba60-ad971227232c"
+		lb := do.LoadBalancer{LoadBalancer: &godo.LoadBalancer{
+			ID:              lbID,
+			ForwardingRules: []godo.ForwardingRule{{EntryProtocol: "https", EntryPort: 443, TargetProtocol: "https", TargetPort: 443, TlsPassthrough: true}},
+		}}
+		tm.loadBalancers.EXPECT().Get(lbID).Return(&lb, nil)
+
+		config.Args = append(config.Args, lbID)
+
+		err := RunLoadBalancerHTTP2Enable(config)
+		assert.EqualError(t, err, "load balancer cde2c0d6-41e3-479e-ba60-ad971227232c has no https forwarding rules without TLS passthrough")
+	})
+}
+
+func TestLoadBalancerHTTP2EnableWithoutHTTPS(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		lbID := "cde2c0d6-41e3-479e-ba60-ad971227232c"
+		lb := do.LoadBalancer{LoadBalancer: &godo.LoadBalancer{
+			ID:              lbID,
+			ForwardingRules: []godo.ForwardingRule{{EntryProtocol: "http", EntryPort: 80, TargetProtocol: "http", TargetPort: 80}},
+		}}
+		tm.loadBalancers.EXPECT().Get(lbID).Return(&lb, nil)
+
+		config.Args = append(config.Args, lbID)
+
+		err := RunLoadBalancerHTTP2Enable(config)
+		assert.EqualError(t, err, "load balancer cde2c0d6-41e3-479e-ba60-ad971227232c has no https forwarding rules")
+	})
+}
+
+func TestLoadBalancerCreateInvalidAlgorithm(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "nyc1")
+		config.Doit.Set(config.NS, doctl.ArgLoadBalancerName, "lb-name")
+		config.Doit.Set(config.NS, doctl.ArgLoadBalancerAlgorithm, "random")
+
+		err := RunLoadBalancerCreate(config)
+		assert.ErrorContains(t, err, `invalid load balancer algorithm "random"`)
+	})
+}
+
+func TestLoadBalancerRequestStats(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		lbID := "cde2c0d6-41e3-479e-ba60-ad971227232c"
+		start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		end := start.Add(time.Hour)
+		ts := metrics.TimeFromUnix(start.Unix())
+		stream := func(labels metrics.Metric, v metrics.SampleValue) metrics.SampleStream {
+			return metrics.SampleStream{Metric: labels, Values: []metrics.SamplePair{{Timestamp: ts, Value: v}}}
+		}
+		resp := func(streams ...metrics.SampleStream) *godo.MetricsResponse {
+			return &godo.MetricsResponse{Data: godo.MetricsData{Result: streams}}
+		}
+
+		tm.monitoring.EXPECT().GetLoadBalancerFrontendHttpRequestsPerSecond(lbID, start, end).Return(resp(stream(nil, 40)), nil)
+		tm.monitoring.EXPECT().GetLoadBalancerDropletsHttpResponseTimeAvg(lbID, start, end).Return(resp(
+			stream(metrics.Metric{"droplet_id": "1"}, 0.1),
+			stream(metrics.Metric{"droplet_id": "2"}, 0.3),
+		), nil)
+		tm.monitoring.EXPECT().GetLoadBalancerDropletsHttpResponseTime95P(lbID, start, end).Return(resp(stream(nil, 0.5)), nil)
+		tm.monitoring.EXPECT().GetLoadBalancerFrontendHttpResponses(lbID, start, end).Return(resp(
+			stream(metrics.Metric{"status_code": "2xx"}, 30),
+			stream(metrics.Metric{"status_code": "5xx"}, 10),
+		), nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Args = append(config.Args, lbID)
+		config.Doit.Set(config.NS, doctl.ArgMetricsStart, start.Format(time.RFC3339))
+		config.Doit.Set(config.NS, doctl.ArgMetricsEnd, end.Format(time.RFC3339))
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := RunLoadBalancerRequestStats(config)
+		require.NoError(t, err)
+		assert.Equal(t, "2026-01-01T00:00:00Z    40.00    0.20    0.50    25.00\n", buf.String())
+	})
 }
 
 func TestLoadBalancerGet(t *testing.T) {
@@ -61,6 +238,89 @@ func TestLoadBalancerList(t *testing.T) {
 	})
 }
 
+func TestLoadBalancerListGroupByRegion(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		list := do.LoadBalancers{
+			{LoadBalancer: &godo.LoadBalancer{Name: "lb-sfo", Region: &godo.Region{Slug: "sfo3"}}},
+			{LoadBalancer: &godo.LoadBalancer{Name: "lb-nyc-1", Region: &godo.Region{Slug: "nyc1"}}},
+			{LoadBalancer: &godo.LoadBalancer{Name: "lb-global"}},
+			{LoadBalancer: &godo.LoadBalancer{Name: "lb-nyc-2", Region: &godo.Region{Slug: "nyc1"}}},
+		}
+		tm.loadBalancers.EXPECT().List().Return(list, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Doit.Set(config.NS, doctl.ArgLoadBalancerGroupByRegion, true)
+		config.Doit.Set(config.NS, doctl.ArgFormat, "Name")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := RunLoadBalancerList(config)
+		assert.NoError(t, err)
+		assert.Equal(t, "Region: global\nlb-global\n\nRegion: nyc1\nlb-nyc-1\nlb-nyc-2\n\nRegion: sfo3\nlb-sfo\n", buf.String())
+	})
+
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		list := do.LoadBalancers{
+			{LoadBalancer: &godo.LoadBalancer{Name: "lb-sfo", Region: &godo.Region{Slug: "sfo3"}}},
+		}
+		tm.loadBalancers.EXPECT().List().Return(list, nil)
+
+		origOutput := Output
+		Output = "yaml"
+		defer func() { Output = origOutput }()
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Doit.Set(config.NS, doctl.ArgLoadBalancerGroupByRegion, true)
+
+		err := RunLoadBalancerList(config)
+		assert.EqualError(t, err, "unknown output type")
+		assert.Empty(t, buf.String())
+	})
+}
+
+func TestLoadBalancerListVPCUUID(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		vpcUUID := "00000000-0000-4000-8000-000000000000"
+		list := do.LoadBalancers{
+			{LoadBalancer: &godo.LoadBalancer{Name: "lb-in-vpc", VPCUUID: vpcUUID}},
+			{LoadBalancer: &godo.LoadBalancer{Name: "lb-elsewhere", VPCUUID: "11111111-0000-4000-8000-000000000000"}},
+		}
+		tm.loadBalancers.EXPECT().List().Return(list, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Doit.Set(config.NS, doctl.ArgVPCUUID, vpcUUID)
+		config.Doit.Set(config.NS, doctl.ArgFormat, "Name")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := RunLoadBalancerList(config)
+		assert.NoError(t, err)
+		assert.Equal(t, "lb-in-vpc\n", buf.String())
+	})
+}
+
+func TestLoadBalancerListCheckHealth(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		list := do.LoadBalancers{
+			{LoadBalancer: &godo.LoadBalancer{ID: "1", Name: "lb-ok", Status: "active", HealthCheck: &godo.HealthCheck{Protocol: "http"}}},
+			{LoadBalancer: &godo.LoadBalancer{ID: "2", Name: "lb-unchecked", Status: "active"}},
+			{LoadBalancer: &godo.LoadBalancer{ID: "3", Name: "lb-broken", Status: "errored"}},
+		}
+		tm.loadBalancers.EXPECT().List().Return(list, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Doit.Set(config.NS, doctl.ArgLoadBalancerCheckHealth, true)
+		config.Doit.Set(config.NS, doctl.ArgFormat, "Name,HealthStatus")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := RunLoadBalancerList(config)
+		assert.NoError(t, err)
+		assert.Equal(t, "lb-ok           healthy\nlb-unchecked    no health check\nlb-broken       errored\n", buf.String())
+	})
+}
+
 func TestLoadBalancerCreateWithInvalidDropletIDsArgs(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		config.Doit.Set(config.NS, doctl.ArgDropletIDs, []string{"bogus"})
@@ -147,6 +407,58 @@ func TestLoadBalancerCreate(t *testing.T) {
 	})
 }
 
+func TestLoadBalancerCreateInternal(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		vpcUUID := "00000000-0000-4000-8000-000000000000"
+		r := godo.LoadBalancerRequest{
+			Name:     "lb-name",
+			Region:   "nyc1",
+			SizeSlug: "lb-small",
+			Type:     "REGIONAL",
+			ForwardingRules: []godo.ForwardingRule{
+				{
+					EntryProtocol:  "http",
+					EntryPort:      80,
+					TargetProtocol: "http",
+					TargetPort:     80,
+				},
+			},
+			HealthCheck:    &godo.HealthCheck{},
+			StickySessions: &godo.StickySessions{},
+			DropletIDs:     []int{},
+			VPCUUID:        vpcUUID,
+			Network:        "INTERNAL",
+		}
+		disableLetsEncryptDNSRecords := true
+		r.DisableLetsEncryptDNSRecords = &disableLetsEncryptDNSRecords
+		tm.loadBalancers.EXPECT().Create(&r).Return(&testLoadBalancer, nil)
+
+		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "nyc1")
+		config.Doit.Set(config.NS, doctl.ArgSizeSlug, "lb-small")
+		config.Doit.Set(config.NS, doctl.ArgLoadBalancerName, "lb-name")
+		config.Doit.Set(config.NS, doctl.ArgLoadBalancerType, "REGIONAL")
+		config.Doit.Set(config.NS, doctl.ArgVPCUUID, vpcUUID)
+		config.Doit.Set(config.NS, doctl.ArgForwardingRules, "entry_protocol:http,entry_port:80,target_protocol:http,target_port:80")
+		config.Doit.Set(config.NS, doctl.ArgDisableLetsEncryptDNSRecords, true)
+		config.Doit.Set(config.NS, doctl.ArgLoadBalancerNetwork, "internal")
+
+		err := RunLoadBalancerCreate(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestLoadBalancerCreateInternalWithoutVPC(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "nyc1")
+		config.Doit.Set(config.NS, doctl.ArgLoadBalancerName, "lb-name")
+		config.Doit.Set(config.NS, doctl.ArgForwardingRules, "entry_protocol:http,entry_port:80,target_protocol:http,target_port:80")
+		config.Doit.Set(config.NS, doctl.ArgLoadBalancerNetwork, "INTERNAL")
+
+		err := RunLoadBalancerCreate(config)
+		assert.EqualError(t, err, "the --vpc-uuid flag is required when creating an INTERNAL load balancer")
+	})
+}
+
 func TestLoadBalancerCreateGLB(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		r := godo.LoadBalancerRequest{
@@ -349,6 +661,310 @@ func TestLoadBalancerUpdateGLB(t *testing.T) {
 	})
 }
 
+func TestLoadBalancerUpdatePatchFile(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		lbID := "cde2c0d6-41e3-479e-ba60-ad971227232c"
+
+		patchFile := filepath.Join(t.TempDir(), "patch.json")
+		err := os.WriteFile(patchFile, []byte(`{"name": "lb-renamed", "droplet_ids": [3, 4], "sticky_sessions": null}`), 0644)
+		require.NoError(t, err)
+
+		r := godo.LoadBalancerRequest{
+			Name:        "lb-renamed",
+			Algorithm:   "round_robin",
+			Region:      "nyc1",
+			SizeSlug:    "lb-small",
+			DropletIDs:  []int{3, 4},
+			HealthCheck: &godo.HealthCheck{},
+		}
+		tm.loadBalancers.EXPECT().Get(lbID).Return(&testLoadBalancer, nil)
+		tm.loadBalancers.EXPECT().Update(lbID, &r).Return(&testLoadBalancer, nil)
+
+		config.Command = &cobra.Command{}
+		config.Args = append(config.Args, lbID)
+		config.Doit.Set(config.NS, doctl.ArgLoadBalancerPatchFile, patchFile)
+
+		err = RunLoadBalancerUpdate(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestLoadBalancerUpdatePatchFileNotObject(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		patchFile := filepath.Join(t.TempDir(), "patch.json")
+		err := os.WriteFile(patchFile, []byte(`["name"]`), 0644)
+		require.NoError(t, err)
+
+		config.Command = &cobra.Command{}
+		config.Args = append(config.Args, "cde2c0d6-41e3-479e-ba60-ad971227232c")
+		config.Doit.Set(config.NS, doctl.ArgLoadBalancerPatchFile, patchFile)
+
+		err = RunLoadBalancerUpdate(config)
+		assert.Error(t, err)
+	})
+}
+
+func TestLoadBalancerUpdatePatchFileWithConfigFlag(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		patchFile := filepath.Join(t.TempDir(), "patch.json")
+		err := os.WriteFile(patchFile, []byte(`{"name": "lb-renamed"}`), 0644)
+		require.NoError(t, err)
+
+		cmd := &cobra.Command{}
+		cmd.Flags().String(doctl.ArgLoadBalancerPatchFile, "", "")
+		cmd.Flags().String(doctl.ArgLoadBalancerName, "", "")
+		cmd.Flags().String(doctl.ArgFormat, "", "")
+		require.NoError(t, cmd.Flags().Set(doctl.ArgLoadBalancerPatchFile, patchFile))
+		require.NoError(t, cmd.Flags().Set(doctl.ArgFormat, "ID"))
+		require.NoError(t, cmd.Flags().Set(doctl.ArgLoadBalancerName, "other-name"))
+
+		config.Command = cmd
+		config.Args = append(config.Args, "cde2c0d6-41e3-479e-ba60-ad971227232c")
+		config.Doit.Set(config.NS, doctl.ArgLoadBalancerPatchFile, patchFile)
+		config.Doit.Set(config.NS, doctl.ArgLoadBalancerName, "other-name")
+
+		err = RunLoadBalancerUpdate(config)
+		assert.EqualError(t, err, "the --name flag cannot be combined with --patch-file; put the change in the patch file instead")
+	})
+}
+
+func TestLoadBalancerHealthCheckGet(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		lbID := "cde2c0d6-41e3-479e-ba60-ad971227232c"
+		lb := do.LoadBalancer{LoadBalancer: &godo.LoadBalancer{
+			ID: lbID,
+			HealthCheck: &godo.HealthCheck{
+				Protocol:             "http",
+				Port:                 80,
+				Path:                 "/healthz",
+				CheckIntervalSeconds: 10,
+			},
+		}}
+		tm.loadBalancers.EXPECT().Get(lbID).Return(&lb, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Args = append(config.Args, lbID)
+		config.Doit.Set(config.NS, doctl.ArgFormat, "Protocol,Port,Path,CheckIntervalSeconds")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := RunLoadBalancerHealthCheckGet(config)
+		assert.NoError(t, err)
+		assert.Equal(t, "http    80    /healthz    10\n", buf.String())
+	})
+}
+
+func TestLoadBalancerStickySessionsGet(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		lbID := "cde2c0d6-41e3-479e-ba60-ad971227232c"
+		lb := do.LoadBalancer{LoadBalancer: &godo.LoadBalancer{
+			ID:             lbID,
+			StickySessions: &godo.StickySessions{Type: "cookies", CookieName: "DO-LB", CookieTtlSeconds: 300},
+		}}
+		tm.loadBalancers.EXPECT().Get(lbID).Return(&lb, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Args = append(config.Args, lbID)
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := RunLoadBalancerStickySessionsGet(config)
+		assert.NoError(t, err)
+		assert.Equal(t, "cookies    DO-LB    300\n", buf.String())
+	})
+}
+
+func TestLoadBalancerStickySessionsSet(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		lbID := "cde2c0d6-41e3-479e-ba60-ad971227232c"
+		lb := do.LoadBalancer{LoadBalancer: &godo.LoadBalancer{
+			ID:             lbID,
+			Name:           "example-lb",
+			Region:         &godo.Region{Slug: "nyc1"},
+			StickySessions: &godo.StickySessions{Type: "none"},
+		}}
+		tm.loadBalancers.EXPECT().Get(lbID).Return(&lb, nil)
+		tm.loadBalancers.EXPECT().Update(lbID, gomock.Any()).DoAndReturn(func(_ string, r *godo.LoadBalancerRequest) (*do.LoadBalancer, error) {
+			assert.Equal(t, "example-lb", r.Name)
+			assert.Equal(t, &godo.StickySessions{Type: "cookies", CookieName: "DO-LB", CookieTtlSeconds: 3600}, r.StickySessions)
+			return &do.LoadBalancer{LoadBalancer: &godo.LoadBalancer{ID: lbID, StickySessions: r.StickySessions}}, nil
+		})
+
+		config.Args = append(config.Args, lbID)
+		config.Doit.Set(config.NS, doctl.ArgStickySessionsType, "cookies")
+		config.Doit.Set(config.NS, doctl.ArgStickySessionsCookieName, "DO-LB")
+		config.Doit.Set(config.NS, doctl.ArgStickySessionsCookieTTL, 3600)
+
+		err := RunLoadBalancerStickySessionsSet(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestLoadBalancerStickySessionsSetInvalidType(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Args = append(config.Args, "cde2c0d6-41e3-479e-ba60-ad971227232c")
+		config.Doit.Set(config.NS, doctl.ArgStickySessionsType, "none")
+
+		err := RunLoadBalancerStickySessionsSet(config)
+		assert.ErrorContains(t, err, `invalid value "none" for --type`)
+	})
+}
+
+func TestLoadBalancerStickySessionsDisable(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		lbID := "cde2c0d6-41e3-479e-ba60-ad971227232c"
+		lb := do.LoadBalancer{LoadBalancer: &godo.LoadBalancer{
+			ID:             lbID,
+			Region:         &godo.Region{Slug: "nyc1"},
+			StickySessions: &godo.StickySessions{Type: "cookies", CookieName: "DO-LB", CookieTtlSeconds: 300},
+		}}
+		tm.loadBalancers.EXPECT().Get(lbID).Return(&lb, nil)
+		tm.loadBalancers.EXPECT().Update(lbID, gomock.Any()).DoAndReturn(func(_ string, r *godo.LoadBalancerRequest) (*do.LoadBalancer, error) {
+			assert.Equal(t, &godo.StickySessions{Type: "none"}, r.StickySessions)
+			return &do.LoadBalancer{LoadBalancer: &godo.LoadBalancer{ID: lbID, StickySessions: r.StickySessions}}, nil
+		})
+
+		config.Args = append(config.Args, lbID)
+
+		err := RunLoadBalancerStickySessionsDisable(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestLoadBalancerHealthCheckGetNone(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		lbID := "cde2c0d6-41e3-479e-ba60-ad971227232c"
+		tm.loadBalancers.EXPECT().Get(lbID).Return(&do.LoadBalancer{LoadBalancer: &godo.LoadBalancer{ID: lbID}}, nil)
+
+		config.Args = append(config.Args, lbID)
+
+		err := RunLoadBalancerHealthCheckGet(config)
+		assert.EqualError(t, err, "load balancer cde2c0d6-41e3-479e-ba60-ad971227232c has no health check configured")
+	})
+}
+
+func TestLoadBalancerHealthCheckTest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/healthz":
+			w.Write([]byte("ok"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte("not found"))
+		}
+	}))
+	defer server.Close()
+
+	_, portStr, err := net.SplitHostPort(server.Listener.Addr().String())
+	require.NoError(t, err)
+	port, err := strconv.Atoi(portStr)
+	require.NoError(t, err)
+
+	lbID := "cde2c0d6-41e3-479e-ba60-ad971227232c"
+	droplet := &do.Droplet{Droplet: &godo.Droplet{
+		ID: 1,
+		Networks: &godo.Networks{
+			V4: []godo.NetworkV4{{IPAddress: "127.0.0.1", Type: "public"}},
+		},
+	}}
+	lbWithPath := func(path string) *do.LoadBalancer {
+		return &do.LoadBalancer{LoadBalancer: &godo.LoadBalancer{
+			ID:         lbID,
+			DropletIDs: []int{1},
+			HealthCheck: &godo.HealthCheck{
+				Protocol:               "http",
+				Port:                   port,
+				Path:                   path,
+				ResponseTimeoutSeconds: 5,
+			},
+		}}
+	}
+
+	healthChecks := func(value float64) *godo.MetricsResponse {
+		return &godo.MetricsResponse{
+			Data: godo.MetricsData{Result: []metrics.SampleStream{{
+				Metric: metrics.Metric{"droplet_id": "1"},
+				Values: []metrics.SamplePair{{Value: metrics.SampleValue(value)}},
+			}}},
+		}
+	}
+
+	t.Run("healthy", func(t *testing.T) {
+		withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+			tm.loadBalancers.EXPECT().Get(lbID).Return(lbWithPath("/healthz"), nil)
+			tm.droplets.EXPECT().Get(1).Return(droplet, nil)
+			tm.monitoring.EXPECT().GetLoadBalancerDropletsHealthChecks(lbID, gomock.Any(), gomock.Any()).Return(healthChecks(1), nil)
+
+			var buf bytes.Buffer
+			config.Out = &buf
+			config.Args = append(config.Args, lbID)
+			config.Doit.Set(config.NS, doctl.ArgLoadBalancerBackend, "1")
+
+			var err error
+			warnings := captureStderr(t, func() { err = RunLoadBalancerHealthCheckTest(config) })
+			require.NoError(t, err)
+			assert.Contains(t, buf.String(), "Response code: 200")
+			assert.Contains(t, buf.String(), "ok")
+			assert.Empty(t, warnings)
+		})
+	})
+
+	t.Run("reachable but reported unhealthy", func(t *testing.T) {
+		withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+			tm.loadBalancers.EXPECT().Get(lbID).Return(lbWithPath("/healthz"), nil)
+			tm.droplets.EXPECT().Get(1).Return(droplet, nil)
+			tm.monitoring.EXPECT().GetLoadBalancerDropletsHealthChecks(lbID, gomock.Any(), gomock.Any()).Return(healthChecks(0), nil)
+
+			var buf bytes.Buffer
+			config.Out = &buf
+			config.Args = append(config.Args, lbID)
+			config.Doit.Set(config.NS, doctl.ArgLoadBalancerBackend, "1")
+
+			var err error
+			warnings := captureStderr(t, func() { err = RunLoadBalancerHealthCheckTest(config) })
+			require.NoError(t, err)
+			assert.Contains(t, buf.String(), "Response code: 200")
+			assert.Contains(t, warnings, "the load balancer reports Droplet 1 as unhealthy")
+		})
+	})
+
+	t.Run("unhealthy", func(t *testing.T) {
+		withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+			tm.loadBalancers.EXPECT().Get(lbID).Return(lbWithPath("/wrong"), nil)
+			tm.droplets.EXPECT().Get(1).Return(droplet, nil)
+
+			var buf bytes.Buffer
+			config.Out = &buf
+			config.Args = append(config.Args, lbID)
+			config.Doit.Set(config.NS, doctl.ArgLoadBalancerBackend, "1")
+
+			err := RunLoadBalancerHealthCheckTest(config)
+			assert.EqualError(t, err, "health check failed: expected a 2xx response code but got 404")
+			assert.Contains(t, buf.String(), "Response code: 404")
+		})
+	})
+}
+
+func TestJSONMergePatch(t *testing.T) {
+	target := map[string]any{
+		"a": "b",
+		"c": map[string]any{"d": "e", "f": "g"},
+		"h": []any{"i"},
+	}
+	patch := map[string]any{
+		"a": "z",
+		"c": map[string]any{"f": nil},
+		"h": []any{"j", "k"},
+	}
+	expected := map[string]any{
+		"a": "z",
+		"c": map[string]any{"d": "e"},
+		"h": []any{"j", "k"},
+	}
+
+	assert.Equal(t, expected, jsonMergePatch(target, patch))
+}
+
 func TestLoadBalancerUpdateNoID(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		err := RunLoadBalancerUpdate(config)
@@ -16,12 +16,16 @@ package commands
 import (
 	"errors"
 	"fmt"
+	"math"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/commands/displayers"
 	"github.com/digitalocean/doctl/do"
 	"github.com/digitalocean/godo"
+	"github.com/digitalocean/godo/metrics"
 	"github.com/spf13/cobra"
 )
 
@@ -61,7 +65,7 @@ If you'd like to alert on the uptime of a specific URL or IP address, use ` + "`
 	
 	For example, you can create a policy that monitors a Droplet's CPU usage and triggers an alert when the Droplet's CPU usage exceeds more than 80% for more than 10 minutes.
 	
-	For a full list of policy types you can set up, see our API documentation: https://docs.digitalocean.com/reference/api/api-reference/#operation/monitoring_create_alertPolicy`, Writer)
+	For a full list of policy types you can set up, see our API documentation: https://docs.digitalocean.com/reference/api/api-reference/#operation/monitoring_create_alertPolicy`, Writer, displayerType(&displayers.AlertPolicy{}))
 	AddStringFlag(cmdAlertPolicyCreate, doctl.ArgAlertPolicyDescription, "", "", "A description of the alert policy")
 	AddStringFlag(cmdAlertPolicyCreate, doctl.ArgAlertPolicyType, "", "", "The type of alert policy. For example,`v1/insights/droplet/memory_utilization_percent` alerts on the percent of memory utilization. For a full list of alert types, see https://docs.digitalocean.com/reference/api/api-reference/#operation/monitoring_create_alertPolicy")
 	AddStringFlag(cmdAlertPolicyCreate, doctl.ArgAlertPolicyCompare, "", "", "The comparator of the alert policy. Possible values: `GreaterThan` or `LessThan`")
@@ -444,3 +448,167 @@ func RunCmdAlertPolicyDelete(c *CmdConfig) error {
 
 	return nil
 }
+
+// defaultMetricsRange is how far back metrics commands look when no
+// `--start` is given.
+const defaultMetricsRange = time.Hour
+
+func addMetricsTimeRangeFlags(cmd *Command) {
+	AddStringFlag(cmd, doctl.ArgMetricsStart, "", "", "The start of the time range, in RFC3339 format. Defaults to one hour before `--end`.")
+	AddStringFlag(cmd, doctl.ArgMetricsEnd, "", "", "The end of the time range, in RFC3339 format. Defaults to now.")
+}
+
+func addMetricsResolutionFlag(cmd *Command) {
+	AddDurationFlag(cmd, doctl.ArgMetricsResolution, "", 0, "Averages samples over intervals of this length, for example `5m` or `1h`. By default, samples are shown at the resolution returned by the API.")
+}
+
+// metricsTimeRange returns the time range set by the `--start` and `--end`
+// flags.
+func metricsTimeRange(c *CmdConfig) (time.Time, time.Time, error) {
+	var start, end time.Time
+
+	endStr, err := c.Doit.GetString(c.NS, doctl.ArgMetricsEnd)
+	if err != nil {
+		return start, end, err
+	}
+	end = time.Now()
+	if endStr != "" {
+		if end, err = time.Parse(time.RFC3339, endStr); err != nil {
+			return start, end, fmt.Errorf("invalid --%s: %v", doctl.ArgMetricsEnd, err)
+		}
+	}
+
+	startStr, err := c.Doit.GetString(c.NS, doctl.ArgMetricsStart)
+	if err != nil {
+		return start, end, err
+	}
+	start = end.Add(-defaultMetricsRange)
+	if startStr != "" {
+		if start, err = time.Parse(time.RFC3339, startStr); err != nil {
+			return start, end, fmt.Errorf("invalid --%s: %v", doctl.ArgMetricsStart, err)
+		}
+	}
+
+	if !start.Before(end) {
+		return start, end, fmt.Errorf("--%s must be before --%s", doctl.ArgMetricsStart, doctl.ArgMetricsEnd)
+	}
+
+	return start, end, nil
+}
+
+// metricPoints merges the samples of each named series into points ordered
+// by time.
+func metricPoints(series map[string][]metrics.SamplePair) []displayers.MetricPoint {
+	byTime := make(map[metrics.Time]map[string]float64)
+	for name, samples := range series {
+		for _, s := range samples {
+			values, ok := byTime[s.Timestamp]
+			if !ok {
+				values = make(map[string]float64)
+				byTime[s.Timestamp] = values
+			}
+			values[name] = float64(s.Value)
+		}
+	}
+
+	points := make([]displayers.MetricPoint, 0, len(byTime))
+	for ts, values := range byTime {
+		points = append(points, displayers.MetricPoint{Time: ts.Time(), Values: values})
+	}
+	sort.Slice(points, func(i, j int) bool {
+		return points[i].Time.Before(points[j].Time)
+	})
+
+	return points
+}
+
+// firstMetricSamples returns the samples of the first stream in a metrics
+// response, or nil if it has none.
+func firstMetricSamples(resp *godo.MetricsResponse) []metrics.SamplePair {
+	if resp == nil || len(resp.Data.Result) == 0 {
+		return nil
+	}
+	return resp.Data.Result[0].Values
+}
+
+// sumMetricStreams adds up the samples of the given streams that share a
+// timestamp.
+func sumMetricStreams(streams []metrics.SampleStream) []metrics.SamplePair {
+	return aggregateMetricStreams(streams, false)
+}
+
+// meanMetricStreams averages the samples of the given streams that share a
+// timestamp.
+func meanMetricStreams(streams []metrics.SampleStream) []metrics.SamplePair {
+	return aggregateMetricStreams(streams, true)
+}
+
+func aggregateMetricStreams(streams []metrics.SampleStream, mean bool) []metrics.SamplePair {
+	sums := make(map[metrics.Time]metrics.SampleValue)
+	counts := make(map[metrics.Time]int)
+	for _, stream := range streams {
+		for _, s := range stream.Values {
+			sums[s.Timestamp] += s.Value
+			counts[s.Timestamp]++
+		}
+	}
+
+	samples := make([]metrics.SamplePair, 0, len(sums))
+	for ts, v := range sums {
+		if mean {
+			v /= metrics.SampleValue(counts[ts])
+		}
+		samples = append(samples, metrics.SamplePair{Timestamp: ts, Value: v})
+	}
+	sort.Slice(samples, func(i, j int) bool {
+		return samples[i].Timestamp.Before(samples[j].Timestamp)
+	})
+
+	return samples
+}
+
+// metricPercentile returns the p-th percentile of a series using the
+// nearest-rank method.
+func metricPercentile(samples []metrics.SamplePair, p float64) float64 {
+	if len(samples) == 0 {
+		return 0
+	}
+
+	values := make([]float64, len(samples))
+	for i, s := range samples {
+		values[i] = float64(s.Value)
+	}
+	sort.Float64s(values)
+
+	rank := int(math.Ceil(p / 100 * float64(len(values))))
+	return values[max(rank, 1)-1]
+}
+
+// resampleMetricPoints averages points over buckets of the given resolution.
+// A resolution of zero returns the points unchanged.
+func resampleMetricPoints(points []displayers.MetricPoint, resolution time.Duration) []displayers.MetricPoint {
+	if resolution <= 0 {
+		return points
+	}
+
+	var (
+		resampled []displayers.MetricPoint
+		counts    map[string]int
+	)
+	for _, p := range points {
+		bucket := p.Time.Truncate(resolution)
+		if len(resampled) == 0 || !resampled[len(resampled)-1].Time.Equal(bucket) {
+			resampled = append(resampled, displayers.MetricPoint{Time: bucket, Values: make(map[string]float64)})
+			counts = make(map[string]int)
+		}
+
+		last := resampled[len(resampled)-1]
+		for name, v := range p.Values {
+			n := counts[name]
+			last.Values[name] = (last.Values[name]*float64(n) + v) / float64(n+1)
+			counts[name] = n + 1
+		}
+	}
+
+	return resampled
+}
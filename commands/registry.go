@@ -68,7 +68,7 @@ func Registry() *Command {
 
 	createRegDesc := "Creates a new private container registry with the provided name."
 	cmdRunRegistryCreate := CmdBuilder(cmd, RunRegistryCreate, "create <registry-name>",
-		"Create a private container registry", createRegDesc, Writer)
+		"Create a private container registry", createRegDesc, Writer, displayerType(&displayers.Registry{}))
 	AddStringFlag(cmdRunRegistryCreate, doctl.ArgSubscriptionTier, "", "basic",
 		"Subscription tier for the new registry. For a list of possible values, use the `doctl registry options subscription-tiers` command.", requiredOpt())
 	AddStringFlag(cmdRunRegistryCreate, doctl.ArgRegionSlug, "", "",
@@ -1088,7 +1088,7 @@ func Registries() *Command {
 		RunRegistriesCreate, "create <registry-name>",
 		"Create a private container registry",
 		createRegistriesDesc,
-		Writer, aliasOpt("c"),
+		Writer, aliasOpt("c"), displayerType(&displayers.Registry{}),
 	)
 	AddStringFlag(cmdRunRegistriesCreate, doctl.ArgSubscriptionTier, "", "basic",
 		"Subscription tier for the new registry. For a list of possible values, use the `doctl registries options subscription-tiers` command.", requiredOpt())
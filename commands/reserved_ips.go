@@ -59,9 +59,10 @@ Reserved IP addresses can either be assigned to Droplets or held in the region t
 	AddBoolFlag(cmdRunReservedIPDelete, doctl.ArgForce, doctl.ArgShortForce, false, "Deletes the reserved IP address without confirmation")
 	cmdRunReservedIPDelete.Example = `The following example deletes the reserved IP address ` + "`" + `203.0.113.25` + "`" + `: doctl compute reserved-ip delete 203.0.113.25`
 
-	cmdReservedIPList := CmdBuilder(cmd, RunReservedIPList, "list", "List all reserved IP addresses on your account", "Retrieves a list of all the reserved IP addresses on your account.", Writer,
+	cmdReservedIPList := CmdBuilder(cmd, RunReservedIPList, "list", "List all reserved IP addresses on your account", "Retrieves a list of all the reserved IP addresses on your account. The `DropletName` column shows the name of the Droplet each IP is assigned to and is empty for unassigned IPs. It can also be requested as `AssignedDropletName` with `--format`.", Writer,
 		aliasOpt("ls"), displayerType(&displayers.ReservedIP{}))
 	AddStringFlag(cmdReservedIPList, doctl.ArgRegionSlug, "", "", "Retrieves a list of reserved IP addresses in the specified region")
+	AddBoolFlag(cmdReservedIPList, doctl.ArgResolveNames, "", false, "Look up the names of assigned Droplets that are missing from the API response to populate the `DropletName` and `AssignedDropletName` columns")
 	cmdReservedIPList.Example = `The following example lists all reserved IP addresses in the ` + "`" + `nyc1` + "`" + ` region: doctl compute reserved-ip list --region nyc1`
 
 	return cmd
@@ -176,6 +177,40 @@ func RunReservedIPList(c *CmdConfig) error {
 		}
 	}
 
+	resolveNames, err := c.Doit.GetBool(c.NS, doctl.ArgResolveNames)
+	if err != nil {
+		return err
+	}
+	if resolveNames {
+		if err := resolveReservedIPDropletNames(c.Droplets(), rips.ReservedIPs); err != nil {
+			return err
+		}
+	}
+
 	item := rips
 	return c.Display(item)
 }
+
+// resolveReservedIPDropletNames fills in the names of assigned Droplets that
+// are missing from the reserved IPs.
+func resolveReservedIPDropletNames(ds do.DropletsService, rips do.ReservedIPs) error {
+	var ids []int
+	for _, rip := range rips {
+		if rip.Droplet != nil && rip.Droplet.Name == "" {
+			ids = append(ids, rip.Droplet.ID)
+		}
+	}
+
+	names, err := resolveDropletNames(ds, ids)
+	if err != nil {
+		return err
+	}
+
+	for _, rip := range rips {
+		if rip.Droplet != nil && rip.Droplet.Name == "" {
+			rip.Droplet.Name = names[rip.Droplet.ID]
+		}
+	}
+
+	return nil
+}
@@ -14,9 +14,11 @@ limitations under the License.
 package commands
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/digitalocean/doctl"
+	"github.com/digitalocean/doctl/do"
 	"github.com/digitalocean/godo"
 	"github.com/stretchr/testify/assert"
 )
@@ -35,6 +37,48 @@ func TestReservedIPsList(t *testing.T) {
 	})
 }
 
+func TestReservedIPsListResolveNames(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		list := do.ReservedIPs{
+			{ReservedIP: &godo.ReservedIP{IP: "192.0.2.1", Region: &godo.Region{Slug: "nyc1"}, Droplet: &godo.Droplet{ID: 1}}},
+			{ReservedIP: &godo.ReservedIP{IP: "192.0.2.2", Region: &godo.Region{Slug: "nyc1"}, Droplet: &godo.Droplet{ID: 1}}},
+			{ReservedIP: &godo.ReservedIP{IP: "192.0.2.3", Region: &godo.Region{Slug: "nyc1"}}},
+		}
+		tm.reservedIPs.EXPECT().List().Return(list, nil)
+		tm.droplets.EXPECT().Get(1).Return(&testDroplet, nil).Times(1)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Doit.Set(config.NS, doctl.ArgResolveNames, true)
+		config.Doit.Set(config.NS, doctl.ArgFormat, "IP,DropletID,DropletName")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := RunReservedIPList(config)
+		assert.NoError(t, err)
+		assert.Equal(t, "192.0.2.1    1    a-droplet\n192.0.2.2    1    a-droplet\n192.0.2.3         \n", buf.String())
+	})
+}
+
+func TestReservedIPsListAssignedDropletName(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		list := do.ReservedIPs{
+			{ReservedIP: &godo.ReservedIP{IP: "192.0.2.1", Region: &godo.Region{Slug: "nyc1"}, Droplet: &godo.Droplet{ID: 1}}},
+			{ReservedIP: &godo.ReservedIP{IP: "192.0.2.2", Region: &godo.Region{Slug: "nyc1"}}},
+		}
+		tm.reservedIPs.EXPECT().List().Return(list, nil)
+		tm.droplets.EXPECT().Get(1).Return(&testDroplet, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Doit.Set(config.NS, doctl.ArgResolveNames, true)
+		config.Doit.Set(config.NS, doctl.ArgFormat, "IP,AssignedDropletName")
+
+		err := RunReservedIPList(config)
+		assert.NoError(t, err)
+		assert.Equal(t, "IP           Assigned Droplet Name\n192.0.2.1    a-droplet\n192.0.2.2    \n", buf.String())
+	})
+}
+
 func TestReservedIPsGet(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		tm.reservedIPs.EXPECT().Get("127.0.0.1").Return(&testReservedIP, nil)
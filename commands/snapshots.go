@@ -15,6 +15,7 @@ package commands
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/commands/displayers"
@@ -57,11 +58,13 @@ func Snapshot() *Command {
 		Writer, aliasOpt("g"), displayerType(&displayers.Snapshot{}))
 	cmdSnapshotGet.Example = `The following example retrieves information about a Droplet snapshot with ID ` + "`" + `386734086` + "`" + `: doctl compute snapshot get 386734086`
 
-	cmdRunSnapshotDelete := CmdBuilder(cmd, RunSnapshotDelete, "delete <snapshot-id>...",
-		"Delete a snapshot of a Droplet or volume", "Deletes the specified snapshot or volume. This is irreversible.",
+	cmdRunSnapshotDelete := CmdBuilder(cmd, RunSnapshotDelete, "delete <snapshot-id|glob>...",
+		"Delete a snapshot of a Droplet or volume", "Deletes the specified snapshot or volume. This is irreversible.\n\nArguments containing glob characters such as `*` are matched against snapshot names and IDs, and the matching snapshots are listed before you are asked to confirm the deletion.",
 		Writer, aliasOpt("d", "rm"), displayerType(&displayers.Snapshot{}))
 	AddBoolFlag(cmdRunSnapshotDelete, doctl.ArgForce, doctl.ArgShortForce, false, "Delete the snapshot without confirmation")
-	cmdRunSnapshotDelete.Example = `The following example deletes a Droplet snapshot with ID ` + "`" + `386734086` + "`" + `: doctl compute snapshot delete 386734086`
+	cmdRunSnapshotDelete.Example = `The following example deletes a Droplet snapshot with ID ` + "`" + `386734086` + "`" + `: doctl compute snapshot delete 386734086
+
+The following example deletes all snapshots whose names start with ` + "`" + `nightly-` + "`" + `: doctl compute snapshot delete 'nightly-*'`
 
 	return cmd
 }
@@ -175,7 +178,7 @@ func RunSnapshotGet(c *CmdConfig) error {
 	return c.Display(item)
 }
 
-// RunSnapshotDelete destroys snapshot(s) by id
+// RunSnapshotDelete destroys snapshot(s) by id or by name glob
 func RunSnapshotDelete(c *CmdConfig) error {
 	if len(c.Args) == 0 {
 		return doctl.NewMissingArgsErr(c.NS)
@@ -187,7 +190,19 @@ func RunSnapshotDelete(c *CmdConfig) error {
 	}
 
 	ss := c.Snapshots()
-	ids := c.Args
+
+	ids, matched, err := resolveSnapshotDeleteArgs(ss, c.Args)
+	if err != nil {
+		return err
+	}
+
+	if len(matched) > 0 && !force {
+		names := make([]string, 0, len(matched))
+		for _, snapshot := range matched {
+			names = append(names, fmt.Sprintf("  %s (%s)", snapshot.Name, snapshot.ID))
+		}
+		notice("The following snapshots match:\n%s", strings.Join(names, "\n"))
+	}
 
 	if force || AskForConfirmDelete("snapshot", len(ids)) == nil {
 		for _, id := range ids {
@@ -201,3 +216,52 @@ func RunSnapshotDelete(c *CmdConfig) error {
 	}
 	return nil
 }
+
+// resolveSnapshotDeleteArgs returns the IDs of the snapshots to delete. Args
+// containing glob characters are matched against the names and IDs of all
+// snapshots, and the matching snapshots are also returned; other args are
+// used as IDs as-is.
+func resolveSnapshotDeleteArgs(ss do.SnapshotsService, args []string) ([]string, []do.Snapshot, error) {
+	var ids []string
+	var globs []glob.Glob
+	var patterns []string
+	for _, arg := range args {
+		if !strings.ContainsAny(arg, "*?[{") {
+			ids = append(ids, arg)
+			continue
+		}
+
+		g, err := glob.Compile(arg)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unknown glob %q", arg)
+		}
+		globs = append(globs, g)
+		patterns = append(patterns, arg)
+	}
+
+	if len(globs) == 0 {
+		return ids, nil, nil
+	}
+
+	list, err := ss.List()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var matched []do.Snapshot
+	for _, snapshot := range list {
+		for _, g := range globs {
+			if g.Match(snapshot.ID) || g.Match(snapshot.Name) {
+				matched = append(matched, snapshot)
+				ids = append(ids, snapshot.ID)
+				break
+			}
+		}
+	}
+
+	if len(matched) == 0 {
+		return nil, nil, fmt.Errorf("no snapshots match %s", strings.Join(patterns, ", "))
+	}
+
+	return dedupeStrings(ids), matched, nil
+}
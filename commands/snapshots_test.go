@@ -14,9 +14,12 @@ limitations under the License.
 package commands
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/digitalocean/doctl"
+	"github.com/digitalocean/doctl/do"
+	"github.com/digitalocean/godo"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -114,3 +117,55 @@ func TestSnapshotDelete(t *testing.T) {
 
 	})
 }
+
+func TestSnapshotDeleteGlob(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		list := []do.Snapshot{
+			{Snapshot: &godo.Snapshot{ID: "1", Name: "nightly-1"}},
+			{Snapshot: &godo.Snapshot{ID: "2", Name: "weekly-1"}},
+			{Snapshot: &godo.Snapshot{ID: "3", Name: "nightly-2"}},
+		}
+		tm.snapshots.EXPECT().List().Return(list, nil)
+		tm.snapshots.EXPECT().Delete("4").Return(nil)
+		tm.snapshots.EXPECT().Delete("1").Return(nil)
+		tm.snapshots.EXPECT().Delete("3").Return(nil)
+
+		config.Args = append(config.Args, "4", "nightly-*")
+		config.Doit.Set(config.NS, doctl.ArgForce, true)
+
+		err := RunSnapshotDelete(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestSnapshotDeleteGlobListsMatchesOnStderr(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		list := []do.Snapshot{
+			{Snapshot: &godo.Snapshot{ID: "1", Name: "nightly-1"}},
+			{Snapshot: &godo.Snapshot{ID: "2", Name: "weekly-1"}},
+		}
+		tm.snapshots.EXPECT().List().Return(list, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Args = append(config.Args, "nightly-*")
+
+		var err error
+		notices := captureStderr(t, func() { err = RunSnapshotDelete(config) })
+		assert.Error(t, err, "should have been challenged before deletion")
+		assert.Contains(t, notices, "The following snapshots match:\n  nightly-1 (1)\n")
+		assert.Empty(t, buf.String())
+	})
+}
+
+func TestSnapshotDeleteGlobNoMatch(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tm.snapshots.EXPECT().List().Return([]do.Snapshot{testSnapshot}, nil)
+
+		config.Args = append(config.Args, "nightly-*")
+		config.Doit.Set(config.NS, doctl.ArgForce, true)
+
+		err := RunSnapshotDelete(config)
+		assert.EqualError(t, err, "no snapshots match nightly-*")
+	})
+}
@@ -37,7 +37,7 @@ func SpacesKeys() *Command {
 	}
 
 	createSpacesKeyDesc := "Create a key for a Space with the provided name."
-	cmdSpacesKeysCreate := CmdBuilder(cmd, spacesKeysCreate, "create <name>", "Create a key for a Space.", createSpacesKeyDesc, Writer)
+	cmdSpacesKeysCreate := CmdBuilder(cmd, spacesKeysCreate, "create <name>", "Create a key for a Space.", createSpacesKeyDesc, Writer, displayerType(&displayers.SpacesKey{}))
 	AddStringSliceFlag(cmdSpacesKeysCreate, "grants", "g", []string{},
 		`A comma-separated list of grants to add to the key. The permission should be either 'read', 'readwrite', or 'fullaccess'.
 Format: `+"`"+`"bucket=your-bucket;permission=your-permission"`+"`", requiredOpt())
@@ -14,7 +14,12 @@ limitations under the License.
 package commands
 
 import (
+	"fmt"
 	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/commands/displayers"
@@ -37,8 +42,11 @@ DigitalOcean allows you to add SSH public keys to the interface so that you can
 		},
 	}
 
-	CmdBuilder(cmd, RunKeyList, "list", "List all SSH keys on your account", `Use this command to list the id, fingerprint, public_key, and name of all SSH keys on your account.`, Writer,
+	cmdKeyList := CmdBuilder(cmd, RunKeyList, "list", "List all SSH keys on your account", `Use this command to list the id, fingerprint, public_key, and name of all SSH keys on your account.
+
+SSH keys are copied to a Droplet when it is created, and the API does not record which keys a Droplet was created with. Because of this, the list cannot show whether a key is still in use by any Droplet.`, Writer,
 		aliasOpt("ls"), displayerType(&displayers.Key{}))
+	AddStringFlag(cmdKeyList, doctl.ArgSortBy, "", "", "Sort the SSH keys by the given field. Possible values: `id`, `name`, `fingerprint`. By default, keys are listed by ID.")
 
 	CmdBuilder(cmd, RunKeyGet, "get <key-id|key-fingerprint>", "Retrieve information about an SSH key on your account", `Use this command to get the id, fingerprint, public_key, and name of a specific SSH key on your account.`, Writer,
 		aliasOpt("g"), displayerType(&displayers.Key{}))
@@ -57,7 +65,7 @@ Note that importing a key to your account will not add it to any Droplets`, Writ
 		aliasOpt("i"), displayerType(&displayers.Key{}))
 	AddStringFlag(cmdSSHKeysImport, doctl.ArgKeyPublicKeyFile, "", "", "Public key file", requiredOpt())
 
-	cmdRunKeyDelete := CmdBuilder(cmd, RunKeyDelete, "delete <key-id|key-fingerprint>", "Permanently delete an SSH key from your account", `Use this command to permanently delete an SSH key from your account.
+	cmdRunKeyDelete := CmdBuilder(cmd, RunKeyDelete, "delete <key-id|key-fingerprint|key-name>", "Permanently delete an SSH key from your account", `Use this command to permanently delete an SSH key from your account. The key can be specified by its ID, its fingerprint, or its name. If more than one key has the given name, specify the key by ID or fingerprint instead.
 
 Note that this does not delete an SSH key from any Droplets.`, Writer,
 		aliasOpt("d", "rm"))
@@ -70,8 +78,27 @@ Note that this does not delete an SSH key from any Droplets.`, Writer,
 	return cmd
 }
 
+// keySortFields lists the values accepted by ssh-key list --sort-by.
+var keySortFields = []string{"id", "name", "fingerprint"}
+
+// keySortKeys maps each --sort-by value to its ordering.
+var keySortKeys = map[string]func(a, b do.SSHKey) bool{
+	"id":          func(a, b do.SSHKey) bool { return a.ID < b.ID },
+	"name":        func(a, b do.SSHKey) bool { return a.Name < b.Name },
+	"fingerprint": func(a, b do.SSHKey) bool { return a.Fingerprint < b.Fingerprint },
+}
+
 // RunKeyList lists keys.
 func RunKeyList(c *CmdConfig) error {
+	sortBy, err := c.Doit.GetString(c.NS, doctl.ArgSortBy)
+	if err != nil {
+		return err
+	}
+	less, ok := keySortKeys[sortBy]
+	if sortBy != "" && !ok {
+		return fmt.Errorf("invalid value %q for --%s; possible values: %s", sortBy, doctl.ArgSortBy, strings.Join(keySortFields, ", "))
+	}
+
 	ks := c.Keys()
 
 	list, err := ks.List()
@@ -79,6 +106,12 @@ func RunKeyList(c *CmdConfig) error {
 		return err
 	}
 
+	if less != nil {
+		sort.SliceStable(list, func(i, j int) bool {
+			return less(list[i], list[j])
+		})
+	}
+
 	item := &displayers.Key{Keys: list}
 	return c.Display(item)
 }
@@ -188,17 +221,61 @@ func RunKeyDelete(c *CmdConfig) error {
 
 	force, err := c.Doit.GetBool(c.NS, doctl.ArgForce)
 	if err != nil {
-		return nil
+		return err
+	}
+
+	rawKey, err := resolveKeyID(ks, c.Args[0])
+	if err != nil {
+		return err
 	}
 
 	if force || AskForConfirmDelete("SSH key", 1) == nil {
-		rawKey := c.Args[0]
 		return ks.Delete(rawKey)
 	}
 
 	return errOperationAborted
 }
 
+var keyFingerprintRegexp = regexp.MustCompile(`^([0-9a-fA-F]{2}:){15}[0-9a-fA-F]{2}$`)
+
+// resolveKeyID returns an ID or fingerprint identifying the key referred to
+// by idOrName. IDs and fingerprints are returned as is, while names are
+// looked up and must match exactly one key.
+func resolveKeyID(ks do.KeysService, idOrName string) (string, error) {
+	if _, err := strconv.Atoi(idOrName); err == nil || keyFingerprintRegexp.MatchString(idOrName) {
+		return idOrName, nil
+	}
+
+	keys, err := ks.List()
+	if err != nil {
+		return "", err
+	}
+
+	var matches do.SSHKeys
+	for _, k := range keys {
+		if k.Fingerprint == idOrName {
+			return idOrName, nil
+		}
+		if k.Name == idOrName {
+			matches = append(matches, k)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("SSH key with the name %q could not be found", idOrName)
+	case 1:
+		return strconv.Itoa(matches[0].ID), nil
+	}
+
+	ids := make([]string, 0, len(matches))
+	for _, k := range matches {
+		ids = append(ids, fmt.Sprintf("%d (%s)", k.ID, k.Fingerprint))
+	}
+	return "", fmt.Errorf("There are %d SSH keys with the name %q; please specify one by ID or fingerprint: %s",
+		len(matches), idOrName, strings.Join(ids, ", "))
+}
+
 // RunKeyUpdate updates a key.
 func RunKeyUpdate(c *CmdConfig) error {
 	ks := c.Keys()
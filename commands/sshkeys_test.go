@@ -14,6 +14,7 @@ limitations under the License.
 package commands
 
 import (
+	"bytes"
 	"os"
 	"path/filepath"
 	"testing"
@@ -44,6 +45,36 @@ func TestKeysList(t *testing.T) {
 	})
 }
 
+func TestKeysListSortBy(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		keys := do.SSHKeys{
+			{Key: &godo.Key{ID: 1, Name: "work"}},
+			{Key: &godo.Key{ID: 2, Name: "laptop"}},
+			{Key: &godo.Key{ID: 3, Name: "deploy"}},
+		}
+		tm.keys.EXPECT().List().Return(keys, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Doit.Set(config.NS, doctl.ArgSortBy, "name")
+		config.Doit.Set(config.NS, doctl.ArgFormat, "ID")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := RunKeyList(config)
+		assert.NoError(t, err)
+		assert.Equal(t, "3\n2\n1\n", buf.String())
+	})
+}
+
+func TestKeysListSortByInvalid(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Doit.Set(config.NS, doctl.ArgSortBy, "created")
+
+		err := RunKeyList(config)
+		assert.EqualError(t, err, `invalid value "created" for --sort-by; possible values: id, name, fingerprint`)
+	})
+}
+
 func TestKeysGetByID(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		tm.keys.EXPECT().Get("1").Return(&testKey, nil)
@@ -95,16 +126,50 @@ func TestKeysDeleteByID(t *testing.T) {
 
 func TestKeysDeleteByFingerprint(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
-		tm.keys.EXPECT().Delete("fingerprint").Return(nil)
+		fingerprint := "3b:16:bf:e4:8b:00:8b:b8:59:8c:a9:d3:f0:19:45:fa"
+		tm.keys.EXPECT().Delete(fingerprint).Return(nil)
 
-		config.Args = append(config.Args, "fingerprint")
+		config.Args = append(config.Args, fingerprint)
+
+		config.Doit.Set(config.NS, doctl.ArgForce, true)
+
+		err := RunKeyDelete(config)
+		assert.NoError(t, err)
+	})
+
+}
 
+func TestKeysDeleteByName(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		keys := do.SSHKeys{
+			{Key: &godo.Key{ID: 1, Name: "laptop", Fingerprint: "aa"}},
+			{Key: &godo.Key{ID: 2, Name: "desktop", Fingerprint: "bb"}},
+		}
+		tm.keys.EXPECT().List().Return(keys, nil)
+		tm.keys.EXPECT().Delete("2").Return(nil)
+
+		config.Args = append(config.Args, "desktop")
 		config.Doit.Set(config.NS, doctl.ArgForce, true)
 
 		err := RunKeyDelete(config)
 		assert.NoError(t, err)
 	})
+}
+
+func TestKeysDeleteByNameAmbiguous(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		keys := do.SSHKeys{
+			{Key: &godo.Key{ID: 1, Name: "laptop", Fingerprint: "aa"}},
+			{Key: &godo.Key{ID: 2, Name: "laptop", Fingerprint: "bb"}},
+		}
+		tm.keys.EXPECT().List().Return(keys, nil)
 
+		config.Args = append(config.Args, "laptop")
+		config.Doit.Set(config.NS, doctl.ArgForce, true)
+
+		err := RunKeyDelete(config)
+		assert.EqualError(t, err, `There are 2 SSH keys with the name "laptop"; please specify one by ID or fingerprint: 1 (aa), 2 (bb)`)
+	})
 }
 
 func TestKeysUpdateByID(t *testing.T) {
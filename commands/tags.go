@@ -14,6 +14,9 @@ limitations under the License.
 package commands
 
 import (
+	"io"
+	"strings"
+
 	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/commands/displayers"
 	"github.com/digitalocean/doctl/do"
@@ -40,7 +43,7 @@ resources attribute with information about resources that have been tagged.`,
 		},
 	}
 
-	cmdTagCreate := CmdBuilder(cmd, RunCmdTagCreate, "create <tag-name>", "Create a tag", `Creates a new tag that you can apply to resources.`, Writer)
+	cmdTagCreate := CmdBuilder(cmd, RunCmdTagCreate, "create <tag-name>", "Create a tag", `Creates a new tag that you can apply to resources.`, Writer, displayerType(&displayers.Tag{}))
 	cmdTagCreate.Example = `The following example creates a tag name ` + "`" + `web` + "`" + `: doctl compute tag create web`
 
 	cmdTagGet := CmdBuilder(cmd, RunCmdTagGet, "get <tag-name>", "Retrieve information about a tag", `Retrieves the number of resources using the tag.`, Writer,
@@ -188,6 +191,35 @@ func RunCmdRemoveTag(c *CmdConfig) error {
 	return c.Tags().UntagResources(tagName, tagReq)
 }
 
+// tagNamesCompletionFunc completes flag values with the names of the tags on
+// the account. In a comma-separated list, only the last name is completed.
+// Errors are ignored as completions are best effort.
+func tagNamesCompletionFunc(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	c, err := NewCmdConfig("", &doctl.LiveConfig{}, io.Discard, args, true)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	tags, err := c.Tags().List()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	var prefix string
+	if i := strings.LastIndex(toComplete, ","); i >= 0 {
+		prefix, toComplete = toComplete[:i+1], toComplete[i+1:]
+	}
+
+	names := make([]string, 0, len(tags))
+	for _, t := range tags {
+		if strings.HasPrefix(t.Name, toComplete) {
+			names = append(names, prefix+t.Name)
+		}
+	}
+
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
+
 func buildTagResources(urns []string) ([]godo.Resource, error) {
 	resources := []godo.Resource{}
 	for _, u := range urns {
@@ -0,0 +1,47 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/digitalocean/doctl"
+	"github.com/fatih/color"
+)
+
+// addTerraformImportFlag adds the --tf-import flag to a create command.
+func addTerraformImportFlag(cmd *Command) {
+	AddBoolFlag(cmd, doctl.ArgTerraformImport, "", false, "After the resource is created, prints a `terraform import` command for it to stderr")
+}
+
+// invalidTerraformName matches the characters that are not allowed in
+// Terraform resource names.
+var invalidTerraformName = regexp.MustCompile(`[^a-zA-Z0-9_-]`)
+
+// terraformResourceName turns name into a valid Terraform resource name.
+func terraformResourceName(name string) string {
+	name = invalidTerraformName.ReplaceAllString(name, "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') || name[0] == '-' {
+		name = "_" + name
+	}
+	return name
+}
+
+// printTerraformImport prints the command that imports a created resource
+// into Terraform state, such as
+// "terraform import digitalocean_droplet.web 123456".
+func printTerraformImport(resourceType, name, id string) {
+	fmt.Fprintf(color.Output, "terraform import %s.%s %s\n", resourceType, terraformResourceName(name), id)
+}
@@ -0,0 +1,34 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTerraformResourceName(t *testing.T) {
+	tests := map[string]string{
+		"web-01":      "web-01",
+		"example.com": "example_com",
+		"1-api":       "_1-api",
+		"my lb":       "my_lb",
+		"":            "_",
+	}
+
+	for name, expected := range tests {
+		assert.Equal(t, expected, terraformResourceName(name), name)
+	}
+}
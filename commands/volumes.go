@@ -41,7 +41,8 @@ Volumes function as raw block devices, meaning they appear to the operating syst
 
 	cmdRunVolumeList := CmdBuilder(cmd, RunVolumeList, "list", "List block storage volumes by ID", `Lists all of the block storage volumes on your account.`, Writer,
 		aliasOpt("ls"), displayerType(&displayers.Volume{}))
-	AddStringFlag(cmdRunVolumeList, doctl.ArgRegionSlug, "", "", "Filter's volumes by the specified region")
+	AddStringFlag(cmdRunVolumeList, doctl.ArgRegionSlug, "", "", "Only lists volumes in the specified region, for example `nyc1`")
+	AddBoolFlag(cmdRunVolumeList, doctl.ArgResolveNames, "", false, "Add an `AttachedTo` column showing the names of the Droplets each volume is attached to")
 	cmdRunVolumeList.Example = `The following example retrieves a list of volumes on your account in the ` + "`" + `nyc1` + "`" + ` region. The command also uses the ` + "`" + `--format` + "`" + ` flag to return only the name and size of each volume: doctl compute volume list --region nyc1 --format Name,Size`
 
 	cmdVolumeCreate := CmdBuilder(cmd, RunVolumeCreate, "create <volume-name>", "Create a block storage volume", `Creates a block storage volume on your account.
@@ -55,10 +56,13 @@ Use the `+"`"+`doctl compute volume-action attach <volume-id> <droplet-id>`+"`"+
 	AddStringFlag(cmdVolumeCreate, doctl.ArgVolumeDesc, "", "", "A description of the volume")
 	AddStringFlag(cmdVolumeCreate, doctl.ArgVolumeRegion, "", "", "The volume's region. Not compatible with the `--snapshot` flag")
 	AddStringFlag(cmdVolumeCreate, doctl.ArgVolumeSnapshot, "", "", "Creates a volume from the specified snapshot ID. Not compatible with the `--region` flag")
+	AddStringFlag(cmdVolumeCreate, doctl.ArgVolumeSnapshotID, "", "", "Creates a volume from the specified volume snapshot ID. Equivalent to `--snapshot`. The snapshot must be a volume snapshot, not a Droplet snapshot.")
 	AddStringFlag(cmdVolumeCreate, doctl.ArgVolumeFilesystemType, "", "", "The volume's filesystem type: ext4 or xfs. If not specified, the volume is left unformatted")
 	AddStringFlag(cmdVolumeCreate, doctl.ArgVolumeFilesystemLabel, "", "", "The volume's filesystem label")
 	AddStringSliceFlag(cmdVolumeCreate, doctl.ArgTag, "", []string{}, "A comma-separated list of tags to apply to the volume. For example, `--tag frontend` or `--tag frontend,backend`")
-	cmdVolumeCreate.Example = `The following example creates a 4TiB volume named ` + "`" + `example-volume` + "`" + ` in the ` + "`" + `nyc1` + "`" + ` region. The command also applies two tags to the volume: doctl compute volume create example-volume --region nyc1 --size 4TiB --tag frontend,backend`
+	cmdVolumeCreate.Example = `The following example creates a 4TiB volume named ` + "`" + `example-volume` + "`" + ` in the ` + "`" + `nyc1` + "`" + ` region. The command also applies two tags to the volume: doctl compute volume create example-volume --region nyc1 --size 4TiB --tag frontend,backend
+
+The following example creates a 100GiB volume named ` + "`" + `restored-volume` + "`" + ` from the volume snapshot with the ID ` + "`" + `fbe805e8-866b-11e6-96bf-000f53315a41` + "`" + `: doctl compute volume create restored-volume --size 100GiB --snapshot-id fbe805e8-866b-11e6-96bf-000f53315a41`
 
 	cmdRunVolumeDelete := CmdBuilder(cmd, RunVolumeDelete, "delete <volume-id>", "Delete a block storage volume", `Deletes a block storage volume by ID, destroying all of its data and removing it from your account. This is irreversible.`, Writer,
 		aliasOpt("d", "rm"))
@@ -89,7 +93,7 @@ func RunVolumeList(c *CmdConfig) error {
 
 	region, err := c.Doit.GetString(c.NS, doctl.ArgRegionSlug)
 	if err != nil {
-		return nil
+		return err
 	}
 
 	matches := make([]glob.Glob, 0, len(c.Args))
@@ -124,7 +128,7 @@ func RunVolumeList(c *CmdConfig) error {
 		}
 
 		if !skip && region != "" {
-			if region != volume.Region.Slug {
+			if volume.Region == nil || region != volume.Region.Slug {
 				skip = true
 			}
 		}
@@ -134,6 +138,22 @@ func RunVolumeList(c *CmdConfig) error {
 		}
 	}
 	item := &displayers.Volume{Volumes: matchedList}
+
+	resolveNames, err := c.Doit.GetBool(c.NS, doctl.ArgResolveNames)
+	if err != nil {
+		return err
+	}
+	if resolveNames {
+		var dropletIDs []int
+		for _, v := range matchedList {
+			dropletIDs = append(dropletIDs, v.DropletIDs...)
+		}
+		item.DropletNames, err = resolveDropletNames(c.Droplets(), dropletIDs)
+		if err != nil {
+			return err
+		}
+	}
+
 	return c.Display(item)
 }
 
@@ -169,6 +189,18 @@ func RunVolumeCreate(c *CmdConfig) error {
 		return err
 	}
 
+	snapshotIDFlag, err := c.Doit.GetString(c.NS, doctl.ArgVolumeSnapshotID)
+	if err != nil {
+		return err
+	}
+
+	if snapshotIDFlag != "" {
+		if snapshotID != "" && snapshotID != snapshotIDFlag {
+			return fmt.Errorf("only one of --%s and --%s may be specified", doctl.ArgVolumeSnapshot, doctl.ArgVolumeSnapshotID)
+		}
+		snapshotID = snapshotIDFlag
+	}
+
 	if region == "" && snapshotID == "" {
 		errorMsg := fmt.Sprintf("%s.%s || %s.%s", c.NS, doctl.ArgVolumeRegion, c.NS, doctl.ArgVolumeSnapshot)
 		return doctl.NewMissingArgsErr(errorMsg)
@@ -188,6 +220,18 @@ func RunVolumeCreate(c *CmdConfig) error {
 		return err
 	}
 
+	al := c.Volumes()
+
+	if snapshotID != "" {
+		snapshot, err := al.GetSnapshot(snapshotID)
+		if err != nil {
+			return fmt.Errorf("unable to retrieve snapshot %q: %w", snapshotID, err)
+		}
+		if snapshot.ResourceType != "volume" {
+			return fmt.Errorf("snapshot %q is a %s snapshot; only volume snapshots can be used to create a volume", snapshotID, snapshot.ResourceType)
+		}
+	}
+
 	var createVolume godo.VolumeCreateRequest
 
 	createVolume.Name = name
@@ -199,8 +243,6 @@ func RunVolumeCreate(c *CmdConfig) error {
 	createVolume.FilesystemLabel = fsLabel
 	createVolume.Tags = tags
 
-	al := c.Volumes()
-
 	d, err := al.CreateVolume(&createVolume)
 	if err != nil {
 		return err
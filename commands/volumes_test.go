@@ -14,6 +14,7 @@ limitations under the License.
 package commands
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/digitalocean/doctl"
@@ -35,6 +36,13 @@ var (
 	testVolumeList = []do.Volume{
 		testVolume,
 	}
+	testVolumeSnapshot = do.Snapshot{
+		Snapshot: &godo.Snapshot{
+			ID:           "ed6414f7-7873-4dd2-90cf-f4f354c293e6",
+			Name:         "test-volume-snapshot",
+			ResourceType: "volume",
+		},
+	}
 )
 
 func TestVolumeCommand(t *testing.T) {
@@ -63,6 +71,49 @@ func TestVolumesList(t *testing.T) {
 	})
 }
 
+func TestVolumesListResolveNames(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		volumes := []do.Volume{
+			{Volume: &godo.Volume{ID: "vol-1", Name: "one", DropletIDs: []int{1}}},
+			{Volume: &godo.Volume{ID: "vol-2", Name: "two", DropletIDs: []int{1}}},
+			{Volume: &godo.Volume{ID: "vol-3", Name: "three"}},
+		}
+		tm.volumes.EXPECT().List().Return(volumes, nil)
+		tm.droplets.EXPECT().Get(1).Return(&testDroplet, nil).Times(1)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Doit.Set(config.NS, doctl.ArgResolveNames, true)
+		config.Doit.Set(config.NS, doctl.ArgFormat, "Name,AttachedTo")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := RunVolumeList(config)
+		assert.NoError(t, err)
+		assert.Equal(t, "one      a-droplet(1)\ntwo      a-droplet(1)\nthree    \n", buf.String())
+	})
+}
+
+func TestVolumesListRegion(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		volumes := []do.Volume{
+			{Volume: &godo.Volume{ID: "vol-1", Name: "one", Region: &godo.Region{Slug: "nyc1"}}},
+			{Volume: &godo.Volume{ID: "vol-2", Name: "two", Region: &godo.Region{Slug: "sfo3"}}},
+			{Volume: &godo.Volume{ID: "vol-3", Name: "three", Region: &godo.Region{Slug: "nyc1"}}},
+		}
+		tm.volumes.EXPECT().List().Return(volumes, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "nyc1")
+		config.Doit.Set(config.NS, doctl.ArgFormat, "Name")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := RunVolumeList(config)
+		assert.NoError(t, err)
+		assert.Equal(t, "one\nthree\n", buf.String())
+	})
+}
+
 func TestVolumesListID(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		tm.volumes.EXPECT().List().Return(testVolumeList, nil)
@@ -117,6 +168,7 @@ func TestVolumeCreateFromSnapshot(t *testing.T) {
 			Description:   "test description",
 			Tags:          []string{"one", "two"},
 		}
+		tm.volumes.EXPECT().GetSnapshot("ed6414f7-7873-4dd2-90cf-f4f354c293e6").Return(&testVolumeSnapshot, nil)
 		tm.volumes.EXPECT().CreateVolume(&tcr).Return(&testVolume, nil)
 
 		config.Args = append(config.Args, "test-volume")
@@ -131,6 +183,44 @@ func TestVolumeCreateFromSnapshot(t *testing.T) {
 	})
 }
 
+func TestVolumeCreateFromSnapshotID(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		tcr := godo.VolumeCreateRequest{
+			Name:          "test-volume",
+			SizeGigaBytes: 100,
+			SnapshotID:    "ed6414f7-7873-4dd2-90cf-f4f354c293e6",
+		}
+		tm.volumes.EXPECT().GetSnapshot("ed6414f7-7873-4dd2-90cf-f4f354c293e6").Return(&testVolumeSnapshot, nil)
+		tm.volumes.EXPECT().CreateVolume(&tcr).Return(&testVolume, nil)
+
+		config.Args = append(config.Args, "test-volume")
+
+		config.Doit.Set(config.NS, doctl.ArgVolumeSnapshotID, "ed6414f7-7873-4dd2-90cf-f4f354c293e6")
+		config.Doit.Set(config.NS, doctl.ArgVolumeSize, "100GiB")
+
+		err := RunVolumeCreate(config)
+		assert.NoError(t, err)
+	})
+}
+
+func TestVolumeCreateFromDropletSnapshot(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		dropletSnapshot := do.Snapshot{Snapshot: &godo.Snapshot{
+			ID:           "ed6414f7-7873-4dd2-90cf-f4f354c293e6",
+			ResourceType: "droplet",
+		}}
+		tm.volumes.EXPECT().GetSnapshot("ed6414f7-7873-4dd2-90cf-f4f354c293e6").Return(&dropletSnapshot, nil)
+
+		config.Args = append(config.Args, "test-volume")
+
+		config.Doit.Set(config.NS, doctl.ArgVolumeSnapshotID, "ed6414f7-7873-4dd2-90cf-f4f354c293e6")
+		config.Doit.Set(config.NS, doctl.ArgVolumeSize, "100GiB")
+
+		err := RunVolumeCreate(config)
+		assert.ErrorContains(t, err, "only volume snapshots can be used")
+	})
+}
+
 func TestVolumesDelete(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		tm.volumes.EXPECT().DeleteVolume("test-volume").Return(nil)
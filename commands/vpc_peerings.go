@@ -45,7 +45,7 @@ With the VPC Peerings commands, you can get, list, create, update, or delete VPC
 	cmdPeeringList.Example = `The following example lists the VPC Peerings on your account : doctl vpcs peerings list --format Name,VPCIDs`
 
 	cmdPeeringCreate := CmdBuilder(cmd, RunVPCPeeringCreate, "create",
-		"Create a new VPC Peering", "Use this command to create a new VPC Peering on your account.", Writer, aliasOpt("c"))
+		"Create a new VPC Peering", "Use this command to create a new VPC Peering on your account.", Writer, aliasOpt("c"), displayerType(&displayers.VPCPeering{}))
 	AddStringFlag(cmdPeeringCreate, doctl.ArgVPCPeeringVPCIDs, "", "",
 		"Peering VPC IDs should be comma separated", requiredOpt())
 	AddBoolFlag(cmdPeeringCreate, doctl.ArgCommandWait, "", false, "Boolean that specifies whether to wait for a VPC Peering creation to complete before returning control to the terminal")
@@ -15,6 +15,8 @@ package commands
 
 import (
 	"fmt"
+	"net"
+	"strings"
 
 	"github.com/digitalocean/doctl"
 	"github.com/digitalocean/doctl/commands/displayers"
@@ -38,6 +40,7 @@ With the VPC commands, you can list, create, or delete VPCs, and manage their co
 	}
 
 	cmd.AddCommand(VPCPeerings())
+	cmd.AddCommand(vpcMembers())
 
 	vpcDetail := `
 
@@ -45,9 +48,9 @@ With the VPC commands, you can list, create, or delete VPCs, and manage their co
 - The uniform resource name (URN) for the VPC network
 - The VPC network's name
 - The VPC network's description
-- The range of IP addresses in the VPC network, in CIDR notation
+- The range of IP addresses in the VPC network, in CIDR notation (the ` + "`" + `IPRange` + "`" + ` column)
 - The datacenter region slug the VPC network is located in
-- The VPC network's default boolean value indicating whether or not it is the default one for the region
+- The VPC network's default boolean value indicating whether or not it is the default one for the region (the ` + "`" + `Default` + "`" + ` column, also available as ` + "`" + `IsDefault` + "`" + ` with ` + "`" + `--format` + "`" + `)
 - The VPC network's creation date, in ISO8601 combined date and time format
 `
 
@@ -56,7 +59,7 @@ With the VPC commands, you can list, create, or delete VPCs, and manage their co
 	cmdVPCGet.Example = `The following example retrieves information about a VPC network with the ID ` + "`" + `f81d4fae-7dec-11d0-a765-00a0c91e6bf6` + "`" + `: doctl vpcs get f81d4fae-7dec-11d0-a765-00a0c91e6bf6`
 
 	cmdRecordCreate := CmdBuilder(cmd, RunVPCCreate, "create",
-		"Create a new VPC network", "Use this command to create a new VPC network on your account.", Writer, aliasOpt("c"))
+		"Create a new VPC network", "Use this command to create a new VPC network on your account.", Writer, aliasOpt("c"), displayerType(&displayers.VPC{}))
 	AddStringFlag(cmdRecordCreate, doctl.ArgVPCName, "", "",
 		"The VPC network's name", requiredOpt())
 	AddStringFlag(cmdRecordCreate, doctl.ArgVPCDescription, "", "", "A description of the VPC network")
@@ -77,19 +80,79 @@ With the VPC commands, you can list, create, or delete VPCs, and manage their co
 
 	cmdVPCList := CmdBuilder(cmd, RunVPCList, "list", "List VPC networks", "Retrieves a list of the VPCs on your account, including the following information for each:"+vpcDetail, Writer,
 		aliasOpt("ls"), displayerType(&displayers.VPC{}))
-	cmdVPCList.Example = `The following example lists the VPCs on your account and uses the --format flag to return only the name, IP range, and region for each VPC network: doctl vpcs list --format Name,IPRange,Region`
+	AddStringFlag(cmdVPCList, doctl.ArgRegionSlug, "", "", "Lists only the VPCs in the given region, such as `nyc1`")
+	cmdVPCList.Example = `The following example lists the VPCs on your account and uses the --format flag to return only the name, IP range, and region for each VPC network: doctl vpcs list --format Name,IPRange,Region
+
+The following example lists the VPCs in the ` + "`" + `nyc1` + "`" + ` region: doctl vpcs list --region nyc1`
 
 	cmdRunRecordDelete := CmdBuilder(cmd, RunVPCDelete, "delete <vpc-id>",
 		"Permanently delete a VPC network", `Permanently deletes the specified VPC. This is irreversible.
 		
-		You cannot delete VPCs that are default networks for a region. To delete a default VPC network, make another VPC network the default for the region using the `+"`"+`doctl vpcs update <vpc-network-id> --default=true`+"`"+` command, and then delete the target VPC network.`, Writer, aliasOpt("d", "rm"))
+		You cannot delete VPCs that are default networks for a region. To delete a default VPC network, make another VPC network the default for the region using the `+"`"+`doctl vpcs update <vpc-network-id> --default=true`+"`"+` command, and then delete the target VPC network.
+
+Before prompting for confirmation, the command lists the resources that are members of the VPC. The API does not delete VPCs that contain resources, so if the VPC is not empty the command returns an error. Move or delete the resources before deleting the VPC. The `+"`"+`--force`+"`"+` flag skips both the resource check and the prompt.
+
+There is no `+"`"+`--cascade`+"`"+` flag to delete a non-empty VPC: the API refuses the deletion while the VPC has members, and deleting them for you would mean deleting Droplets, databases and Kubernetes clusters as a side effect.`, Writer, aliasOpt("d", "rm"))
 	AddBoolFlag(cmdRunRecordDelete, doctl.ArgForce, doctl.ArgShortForce, false,
-		"Delete the VPC without a confirmation prompt")
+		"Delete the VPC without checking its resources or prompting for confirmation")
 	cmdRunRecordDelete.Example = `The following example deletes the VPC network with the ID ` + "`" + `f81d4fae-7dec-11d0-a765-00a0c91e6bf6` + "`" + `: doctl vpcs delete f81d4fae-7dec-11d0-a765-00a0c91e6bf6`
 
 	return cmd
 }
 
+func vpcMembers() *Command {
+	cmd := &Command{
+		Command: &cobra.Command{
+			Use:   "members",
+			Short: "Display commands for listing the resources in a VPC network",
+			Long:  "The commands under `doctl vpcs members` list the resources, such as Droplets, load balancers, and database clusters, that are members of a VPC network.",
+		},
+	}
+
+	cmdVPCMembersList := CmdBuilder(cmd, RunVPCMembersList, "list <vpc-id>", "List the resources in a VPC network", `Lists the resources in a VPC network, including the following information for each:
+
+- The resource's uniform resource name (URN)
+- The resource's type, such as `+"`"+`droplet`+"`"+` or `+"`"+`load_balancer`+"`"+`
+- The resource's name
+- The region of the VPC network
+- The resource's creation date, in ISO8601 combined date and time format
+
+Check the members of a VPC network before deleting it.`, Writer,
+		aliasOpt("ls"), displayerType(&displayers.VPCMember{}))
+	AddStringFlag(cmdVPCMembersList, doctl.ArgVPCMemberType, "", "", "Lists only resources of the given type. Possible values include `droplet`, `load_balancer`, `kubernetes`, and `database_cluster`.")
+	cmdVPCMembersList.Example = `The following example lists the Droplets in the VPC network with the ID ` + "`" + `f81d4fae-7dec-11d0-a765-00a0c91e6bf6` + "`" + `: doctl vpcs members list f81d4fae-7dec-11d0-a765-00a0c91e6bf6 --type droplet`
+
+	return cmd
+}
+
+// RunVPCMembersList lists the resources in a VPC.
+func RunVPCMembersList(c *CmdConfig) error {
+	err := ensureOneArg(c)
+	if err != nil {
+		return err
+	}
+	vpcUUID := c.Args[0]
+
+	resourceType, err := c.Doit.GetString(c.NS, doctl.ArgVPCMemberType)
+	if err != nil {
+		return err
+	}
+
+	vpcs := c.VPCs()
+	vpc, err := vpcs.Get(vpcUUID)
+	if err != nil {
+		return err
+	}
+
+	members, err := vpcs.ListMembers(vpcUUID, resourceType)
+	if err != nil {
+		return err
+	}
+
+	item := &displayers.VPCMember{VPCMembers: members, Region: vpc.RegionSlug}
+	return c.Display(item)
+}
+
 // RunVPCGet retrieves an existing VPC by its identifier.
 func RunVPCGet(c *CmdConfig) error {
 	err := ensureOneArg(c)
@@ -110,12 +173,27 @@ func RunVPCGet(c *CmdConfig) error {
 
 // RunVPCList lists VPCs.
 func RunVPCList(c *CmdConfig) error {
+	region, err := c.Doit.GetString(c.NS, doctl.ArgRegionSlug)
+	if err != nil {
+		return err
+	}
+
 	vpcs := c.VPCs()
 	list, err := vpcs.List()
 	if err != nil {
 		return err
 	}
 
+	if region != "" {
+		filtered := make(do.VPCs, 0, len(list))
+		for _, vpc := range list {
+			if vpc.RegionSlug == region {
+				filtered = append(filtered, vpc)
+			}
+		}
+		list = filtered
+	}
+
 	item := &displayers.VPC{VPCs: list}
 	return c.Display(item)
 }
@@ -148,6 +226,23 @@ func RunVPCCreate(c *CmdConfig) error {
 	r.RegionSlug = rSlug
 
 	vpcs := c.VPCs()
+
+	if ipRange != "" {
+		_, ipNet, err := net.ParseCIDR(ipRange)
+		if err != nil {
+			return fmt.Errorf("invalid IP range %q: %v", ipRange, err)
+		}
+
+		existing, err := vpcs.List()
+		if err != nil {
+			return err
+		}
+
+		for _, v := range overlappingVPCs(existing, ipNet) {
+			warn("The IP range %s overlaps with the IP range %s of VPC %s (%s)", ipRange, v.IPRange, v.Name, v.ID)
+		}
+	}
+
 	vpc, err := vpcs.Create(r)
 	if err != nil {
 		return err
@@ -157,6 +252,23 @@ func RunVPCCreate(c *CmdConfig) error {
 	return c.Display(item)
 }
 
+// overlappingVPCs returns the VPCs whose IP range overlaps with ipNet.
+func overlappingVPCs(vpcs do.VPCs, ipNet *net.IPNet) do.VPCs {
+	var overlapping do.VPCs
+	for _, v := range vpcs {
+		_, existing, err := net.ParseCIDR(v.IPRange)
+		if err != nil {
+			continue
+		}
+
+		if existing.Contains(ipNet.IP) || ipNet.Contains(existing.IP) {
+			overlapping = append(overlapping, v)
+		}
+	}
+
+	return overlapping
+}
+
 // RunVPCUpdate updates an existing VPC with new configuration.
 func RunVPCUpdate(c *CmdConfig) error {
 	if len(c.Args) == 0 {
@@ -217,8 +329,24 @@ func RunVPCDelete(c *CmdConfig) error {
 		return err
 	}
 
+	vpcs := c.VPCs()
+	if !force {
+		members, err := vpcs.ListMembers(vpcUUID, "")
+		if err != nil {
+			return err
+		}
+
+		if len(members) > 0 {
+			resources := make([]string, 0, len(members))
+			for _, m := range members {
+				resources = append(resources, fmt.Sprintf("  %s (%s)", m.Name, m.URN))
+			}
+			warn("VPC %s contains %d resources:\n%s", vpcUUID, len(members), strings.Join(resources, "\n"))
+			return fmt.Errorf("VPC %s is not empty; move or delete its resources before deleting it", vpcUUID)
+		}
+	}
+
 	if force || AskForConfirmDelete("VPC", 1) == nil {
-		vpcs := c.VPCs()
 		if err := vpcs.Delete(vpcUUID); err != nil {
 			return err
 		}
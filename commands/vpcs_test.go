@@ -1,6 +1,8 @@
 package commands
 
 import (
+	"bytes"
+	"net"
 	"testing"
 
 	"github.com/digitalocean/doctl"
@@ -27,7 +29,7 @@ var (
 func TestVPCsCommand(t *testing.T) {
 	cmd := VPCs()
 	assert.NotNil(t, cmd)
-	assertCommandNames(t, cmd, "get", "list", "create", "update", "delete", "peerings")
+	assertCommandNames(t, cmd, "get", "list", "create", "update", "delete", "peerings", "members")
 }
 
 func TestVPCGet(t *testing.T) {
@@ -58,6 +60,53 @@ func TestVPCList(t *testing.T) {
 	})
 }
 
+func TestVPCListIPRangeAndDefault(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		list := do.VPCs{
+			{VPC: &godo.VPC{ID: "1", IPRange: "10.116.0.0/20", Default: true}},
+			{VPC: &godo.VPC{ID: "2", IPRange: "192.168.0.0/24"}},
+		}
+		tm.vpcs.EXPECT().List().Return(list, nil).Times(2)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+
+		err := RunVPCList(config)
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), "IP Range")
+		assert.Contains(t, buf.String(), "Default")
+
+		buf.Reset()
+		config.Doit.Set(config.NS, doctl.ArgFormat, "ID,IPRange,IsDefault")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err = RunVPCList(config)
+		assert.NoError(t, err)
+		assert.Equal(t, "1    10.116.0.0/20     true\n2    192.168.0.0/24    false\n", buf.String())
+	})
+}
+
+func TestVPCListRegion(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		list := do.VPCs{
+			{VPC: &godo.VPC{ID: "1", RegionSlug: "nyc1"}},
+			{VPC: &godo.VPC{ID: "2", RegionSlug: "sfo3"}},
+			{VPC: &godo.VPC{ID: "3", RegionSlug: "nyc1"}},
+		}
+		tm.vpcs.EXPECT().List().Return(list, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "nyc1")
+		config.Doit.Set(config.NS, doctl.ArgFormat, "ID")
+		config.Doit.Set(config.NS, doctl.ArgNoHeader, true)
+
+		err := RunVPCList(config)
+		assert.NoError(t, err)
+		assert.Equal(t, "1\n3\n", buf.String())
+	})
+}
+
 func TestVPCCreate(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		r := godo.VPCCreateRequest{
@@ -66,6 +115,7 @@ func TestVPCCreate(t *testing.T) {
 			Description: "vpc description",
 			IPRange:     "10.116.0.0/20",
 		}
+		tm.vpcs.EXPECT().List().Return(do.VPCs{}, nil)
 		tm.vpcs.EXPECT().Create(&r).Return(&testVPC, nil)
 
 		config.Doit.Set(config.NS, doctl.ArgVPCName, "vpc-name")
@@ -78,6 +128,35 @@ func TestVPCCreate(t *testing.T) {
 	})
 }
 
+func TestVPCCreateInvalidIPRange(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		config.Doit.Set(config.NS, doctl.ArgVPCName, "vpc-name")
+		config.Doit.Set(config.NS, doctl.ArgRegionSlug, "nyc1")
+		config.Doit.Set(config.NS, doctl.ArgVPCIPRange, "10.116.0.0")
+
+		err := RunVPCCreate(config)
+		assert.Error(t, err)
+	})
+}
+
+func TestOverlappingVPCs(t *testing.T) {
+	vpcs := do.VPCs{
+		{VPC: &godo.VPC{Name: "contains", IPRange: "10.116.0.0/16"}},
+		{VPC: &godo.VPC{Name: "contained", IPRange: "10.116.0.0/24"}},
+		{VPC: &godo.VPC{Name: "adjacent", IPRange: "10.116.16.0/20"}},
+		{VPC: &godo.VPC{Name: "disjoint", IPRange: "192.168.0.0/16"}},
+	}
+
+	_, ipNet, err := net.ParseCIDR("10.116.0.0/20")
+	assert.NoError(t, err)
+
+	var names []string
+	for _, v := range overlappingVPCs(vpcs, ipNet) {
+		names = append(names, v.Name)
+	}
+	assert.Equal(t, []string{"contains", "contained"}, names)
+}
+
 func TestVPCUpdate(t *testing.T) {
 	tests := []struct {
 		desc            string
@@ -176,9 +255,56 @@ func TestVPCDelete(t *testing.T) {
 	})
 }
 
+func TestVPCDeleteWithMembers(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		vpcUUID := "e819b321-a9a1-4078-b437-8e6b8bf13530"
+		members := do.VPCMembers{
+			{VPCMember: &godo.VPCMember{URN: "do:droplet:13457723", Name: "web-1"}},
+		}
+		tm.vpcs.EXPECT().ListMembers(vpcUUID, "").Return(members, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Args = append(config.Args, vpcUUID)
+
+		var err error
+		warnings := captureStderr(t, func() { err = RunVPCDelete(config) })
+		assert.EqualError(t, err, "VPC e819b321-a9a1-4078-b437-8e6b8bf13530 is not empty; move or delete its resources before deleting it")
+		assert.Contains(t, warnings, "VPC e819b321-a9a1-4078-b437-8e6b8bf13530 contains 1 resources:\n  web-1 (do:droplet:13457723)\n")
+		assert.Empty(t, buf.String())
+	})
+}
+
 func TestVPCDeleteNoID(t *testing.T) {
 	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
 		err := RunVPCDelete(config)
 		assert.Error(t, err)
 	})
 }
+
+func TestVPCMembersList(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		vpcUUID := "e819b321-a9a1-4078-b437-8e6b8bf13530"
+		members := do.VPCMembers{
+			{VPCMember: &godo.VPCMember{URN: "do:droplet:13457723", Name: "web-1"}},
+		}
+		tm.vpcs.EXPECT().Get(vpcUUID).Return(&testVPC, nil)
+		tm.vpcs.EXPECT().ListMembers(vpcUUID, "droplet").Return(members, nil)
+
+		var buf bytes.Buffer
+		config.Out = &buf
+		config.Args = append(config.Args, vpcUUID)
+		config.Doit.Set(config.NS, doctl.ArgVPCMemberType, "droplet")
+
+		err := RunVPCMembersList(config)
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), "do:droplet:13457723    droplet    web-1    nyc1")
+	})
+}
+
+func TestVPCMembersListNoID(t *testing.T) {
+	withTestClient(t, func(config *CmdConfig, tm *tcMocks) {
+		err := RunVPCMembersList(config)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2018 The Doctl Authors All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+	http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package do_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/digitalocean/doctl/do"
+	"github.com/digitalocean/godo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pagedAppsService is a godo.AppsService that serves List from a fixed set of pages.
+type pagedAppsService struct {
+	godo.AppsService
+
+	pages [][]*godo.App
+
+	mu           sync.Mutex
+	withProjects []bool
+}
+
+func (s *pagedAppsService) List(_ context.Context, opt *godo.ListOptions) ([]*godo.App, *godo.Response, error) {
+	s.mu.Lock()
+	s.withProjects = append(s.withProjects, opt.WithProjects)
+	s.mu.Unlock()
+
+	resp := &godo.Response{
+		Links: &godo.Links{
+			Pages: &godo.Pages{Last: fmt.Sprintf("https://api.digitalocean.com/v2/apps?page=%d", len(s.pages))},
+		},
+	}
+	return s.pages[opt.Page-1], resp, nil
+}
+
+func TestAppsServiceListPaginated(t *testing.T) {
+	var pages [][]*godo.App
+	for p := 0; p < 3; p++ {
+		var page []*godo.App
+		for i := 0; i < 100; i++ {
+			page = append(page, &godo.App{ID: fmt.Sprintf("app-%d-%d", p, i)})
+		}
+		pages = append(pages, page)
+	}
+
+	gAppsSvc := &pagedAppsService{pages: pages}
+	client := &godo.Client{
+		Apps: gAppsSvc,
+	}
+	as := do.NewAppsService(client)
+
+	apps, err := as.List(true)
+	require.NoError(t, err)
+
+	require.Len(t, apps, 300)
+	assert.Equal(t, "app-0-0", apps[0].ID)
+	assert.Equal(t, "app-1-0", apps[100].ID)
+	assert.Equal(t, "app-2-99", apps[299].ID)
+
+	assert.Equal(t, []bool{true, true, true}, gAppsSvc.withProjects)
+}
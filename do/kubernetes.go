@@ -76,6 +76,7 @@ type KubernetesService interface {
 	GetKubeConfigWithExpiry(clusterID string, expirySeconds int64) ([]byte, error)
 	GetCredentials(clusterID string) (*KubernetesClusterCredentials, error)
 	GetUpgrades(clusterID string) (KubernetesVersions, error)
+	GetStatusMessages(clusterID string) ([]*godo.KubernetesClusterStatusMessage, error)
 	List() (KubernetesClusters, error)
 	ListAssociatedResourcesForDeletion(clusterID string) (*KubernetesAssociatedResources, error)
 	Create(create *godo.KubernetesClusterCreateRequest) (*KubernetesCluster, error)
@@ -152,6 +153,15 @@ func (k8s *kubernetesClusterService) GetCredentials(clusterID string) (*Kubernet
 	}, nil
 }
 
+func (k8s *kubernetesClusterService) GetStatusMessages(clusterID string) ([]*godo.KubernetesClusterStatusMessage, error) {
+	messages, _, err := k8s.client.GetClusterStatusMessages(context.TODO(), clusterID, &godo.KubernetesGetClusterStatusMessagesRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
 func (k8s *kubernetesClusterService) GetUpgrades(clusterID string) (KubernetesVersions, error) {
 	upgrades, _, err := k8s.client.GetUpgrades(context.TODO(), clusterID)
 	if err != nil {
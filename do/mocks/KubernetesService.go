@@ -260,6 +260,21 @@ func (mr *MockKubernetesServiceMockRecorder) GetRegions() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegions", reflect.TypeOf((*MockKubernetesService)(nil).GetRegions))
 }
 
+// GetStatusMessages mocks base method.
+func (m *MockKubernetesService) GetStatusMessages(clusterID string) ([]*godo.KubernetesClusterStatusMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatusMessages", clusterID)
+	ret0, _ := ret[0].([]*godo.KubernetesClusterStatusMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatusMessages indicates an expected call of GetStatusMessages.
+func (mr *MockKubernetesServiceMockRecorder) GetStatusMessages(clusterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatusMessages", reflect.TypeOf((*MockKubernetesService)(nil).GetStatusMessages), clusterID)
+}
+
 // GetUpgrades mocks base method.
 func (m *MockKubernetesService) GetUpgrades(clusterID string) (do.KubernetesVersions, error) {
 	m.ctrl.T.Helper()
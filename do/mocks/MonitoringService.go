@@ -11,6 +11,7 @@ package mocks
 
 import (
 	reflect "reflect"
+	time "time"
 
 	do "github.com/digitalocean/doctl/do"
 	godo "github.com/digitalocean/godo"
@@ -85,6 +86,186 @@ func (mr *MockMonitoringServiceMockRecorder) GetAlertPolicy(arg0 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlertPolicy", reflect.TypeOf((*MockMonitoringService)(nil).GetAlertPolicy), arg0)
 }
 
+// GetAppMetrics mocks base method.
+func (m *MockMonitoringService) GetAppMetrics(appID, component, metric string, start, end time.Time) (*godo.MetricsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAppMetrics", appID, component, metric, start, end)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAppMetrics indicates an expected call of GetAppMetrics.
+func (mr *MockMonitoringServiceMockRecorder) GetAppMetrics(appID, component, metric, start, end any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppMetrics", reflect.TypeOf((*MockMonitoringService)(nil).GetAppMetrics), appID, component, metric, start, end)
+}
+
+// GetDropletAvailableMemory mocks base method.
+func (m *MockMonitoringService) GetDropletAvailableMemory(dropletID string, start, end time.Time) (*godo.MetricsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDropletAvailableMemory", dropletID, start, end)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDropletAvailableMemory indicates an expected call of GetDropletAvailableMemory.
+func (mr *MockMonitoringServiceMockRecorder) GetDropletAvailableMemory(dropletID, start, end any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDropletAvailableMemory", reflect.TypeOf((*MockMonitoringService)(nil).GetDropletAvailableMemory), dropletID, start, end)
+}
+
+// GetDropletBandwidth mocks base method.
+func (m *MockMonitoringService) GetDropletBandwidth(dropletID, iface, direction string, start, end time.Time) (*godo.MetricsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDropletBandwidth", dropletID, iface, direction, start, end)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDropletBandwidth indicates an expected call of GetDropletBandwidth.
+func (mr *MockMonitoringServiceMockRecorder) GetDropletBandwidth(dropletID, iface, direction, start, end any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDropletBandwidth", reflect.TypeOf((*MockMonitoringService)(nil).GetDropletBandwidth), dropletID, iface, direction, start, end)
+}
+
+// GetDropletCPU mocks base method.
+func (m *MockMonitoringService) GetDropletCPU(dropletID string, start, end time.Time) (*godo.MetricsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDropletCPU", dropletID, start, end)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDropletCPU indicates an expected call of GetDropletCPU.
+func (mr *MockMonitoringServiceMockRecorder) GetDropletCPU(dropletID, start, end any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDropletCPU", reflect.TypeOf((*MockMonitoringService)(nil).GetDropletCPU), dropletID, start, end)
+}
+
+// GetDropletCachedMemory mocks base method.
+func (m *MockMonitoringService) GetDropletCachedMemory(dropletID string, start, end time.Time) (*godo.MetricsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDropletCachedMemory", dropletID, start, end)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDropletCachedMemory indicates an expected call of GetDropletCachedMemory.
+func (mr *MockMonitoringServiceMockRecorder) GetDropletCachedMemory(dropletID, start, end any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDropletCachedMemory", reflect.TypeOf((*MockMonitoringService)(nil).GetDropletCachedMemory), dropletID, start, end)
+}
+
+// GetDropletFreeMemory mocks base method.
+func (m *MockMonitoringService) GetDropletFreeMemory(dropletID string, start, end time.Time) (*godo.MetricsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDropletFreeMemory", dropletID, start, end)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDropletFreeMemory indicates an expected call of GetDropletFreeMemory.
+func (mr *MockMonitoringServiceMockRecorder) GetDropletFreeMemory(dropletID, start, end any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDropletFreeMemory", reflect.TypeOf((*MockMonitoringService)(nil).GetDropletFreeMemory), dropletID, start, end)
+}
+
+// GetDropletTotalMemory mocks base method.
+func (m *MockMonitoringService) GetDropletTotalMemory(dropletID string, start, end time.Time) (*godo.MetricsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDropletTotalMemory", dropletID, start, end)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDropletTotalMemory indicates an expected call of GetDropletTotalMemory.
+func (mr *MockMonitoringServiceMockRecorder) GetDropletTotalMemory(dropletID, start, end any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDropletTotalMemory", reflect.TypeOf((*MockMonitoringService)(nil).GetDropletTotalMemory), dropletID, start, end)
+}
+
+// GetLoadBalancerDropletsHealthChecks mocks base method.
+func (m *MockMonitoringService) GetLoadBalancerDropletsHealthChecks(lbID string, start, end time.Time) (*godo.MetricsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoadBalancerDropletsHealthChecks", lbID, start, end)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoadBalancerDropletsHealthChecks indicates an expected call of GetLoadBalancerDropletsHealthChecks.
+func (mr *MockMonitoringServiceMockRecorder) GetLoadBalancerDropletsHealthChecks(lbID, start, end any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerDropletsHealthChecks", reflect.TypeOf((*MockMonitoringService)(nil).GetLoadBalancerDropletsHealthChecks), lbID, start, end)
+}
+
+// GetLoadBalancerDropletsHttpResponseTime95P mocks base method.
+func (m *MockMonitoringService) GetLoadBalancerDropletsHttpResponseTime95P(lbID string, start, end time.Time) (*godo.MetricsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoadBalancerDropletsHttpResponseTime95P", lbID, start, end)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoadBalancerDropletsHttpResponseTime95P indicates an expected call of GetLoadBalancerDropletsHttpResponseTime95P.
+func (mr *MockMonitoringServiceMockRecorder) GetLoadBalancerDropletsHttpResponseTime95P(lbID, start, end any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerDropletsHttpResponseTime95P", reflect.TypeOf((*MockMonitoringService)(nil).GetLoadBalancerDropletsHttpResponseTime95P), lbID, start, end)
+}
+
+// GetLoadBalancerDropletsHttpResponseTimeAvg mocks base method.
+func (m *MockMonitoringService) GetLoadBalancerDropletsHttpResponseTimeAvg(lbID string, start, end time.Time) (*godo.MetricsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoadBalancerDropletsHttpResponseTimeAvg", lbID, start, end)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoadBalancerDropletsHttpResponseTimeAvg indicates an expected call of GetLoadBalancerDropletsHttpResponseTimeAvg.
+func (mr *MockMonitoringServiceMockRecorder) GetLoadBalancerDropletsHttpResponseTimeAvg(lbID, start, end any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerDropletsHttpResponseTimeAvg", reflect.TypeOf((*MockMonitoringService)(nil).GetLoadBalancerDropletsHttpResponseTimeAvg), lbID, start, end)
+}
+
+// GetLoadBalancerFrontendHttpRequestsPerSecond mocks base method.
+func (m *MockMonitoringService) GetLoadBalancerFrontendHttpRequestsPerSecond(lbID string, start, end time.Time) (*godo.MetricsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoadBalancerFrontendHttpRequestsPerSecond", lbID, start, end)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoadBalancerFrontendHttpRequestsPerSecond indicates an expected call of GetLoadBalancerFrontendHttpRequestsPerSecond.
+func (mr *MockMonitoringServiceMockRecorder) GetLoadBalancerFrontendHttpRequestsPerSecond(lbID, start, end any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerFrontendHttpRequestsPerSecond", reflect.TypeOf((*MockMonitoringService)(nil).GetLoadBalancerFrontendHttpRequestsPerSecond), lbID, start, end)
+}
+
+// GetLoadBalancerFrontendHttpResponses mocks base method.
+func (m *MockMonitoringService) GetLoadBalancerFrontendHttpResponses(lbID string, start, end time.Time) (*godo.MetricsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoadBalancerFrontendHttpResponses", lbID, start, end)
+	ret0, _ := ret[0].(*godo.MetricsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoadBalancerFrontendHttpResponses indicates an expected call of GetLoadBalancerFrontendHttpResponses.
+func (mr *MockMonitoringServiceMockRecorder) GetLoadBalancerFrontendHttpResponses(lbID, start, end any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerFrontendHttpResponses", reflect.TypeOf((*MockMonitoringService)(nil).GetLoadBalancerFrontendHttpResponses), lbID, start, end)
+}
+
 // ListAlertPolicies mocks base method.
 func (m *MockMonitoringService) ListAlertPolicies() (do.AlertPolicies, error) {
 	m.ctrl.T.Helper()
@@ -144,6 +144,21 @@ func (mr *MockVPCsServiceMockRecorder) List() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockVPCsService)(nil).List))
 }
 
+// ListMembers mocks base method.
+func (m *MockVPCsService) ListMembers(vpcUUID, resourceType string) (do.VPCMembers, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMembers", vpcUUID, resourceType)
+	ret0, _ := ret[0].(do.VPCMembers)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMembers indicates an expected call of ListMembers.
+func (mr *MockVPCsServiceMockRecorder) ListMembers(vpcUUID, resourceType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembers", reflect.TypeOf((*MockVPCsService)(nil).ListMembers), vpcUUID, resourceType)
+}
+
 // ListVPCPeerings mocks base method.
 func (m *MockVPCsService) ListVPCPeerings() (do.VPCPeerings, error) {
 	m.ctrl.T.Helper()
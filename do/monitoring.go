@@ -15,10 +15,18 @@ package do
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/digitalocean/godo"
 )
 
+// appMetricsPath is the monitoring API endpoint for App Platform metrics,
+// which godo does not wrap yet.
+const appMetricsPath = "v2/monitoring/metrics/apps/%s"
+
 // AlertPolicy is a wrapper for godo.AlertPolicy
 type AlertPolicy struct {
 	*godo.AlertPolicy
@@ -34,6 +42,18 @@ type MonitoringService interface {
 	CreateAlertPolicy(request *godo.AlertPolicyCreateRequest) (*AlertPolicy, error)
 	UpdateAlertPolicy(uuid string, request *godo.AlertPolicyUpdateRequest) (*AlertPolicy, error)
 	DeleteAlertPolicy(string) error
+	GetLoadBalancerDropletsHealthChecks(lbID string, start, end time.Time) (*godo.MetricsResponse, error)
+	GetLoadBalancerFrontendHttpRequestsPerSecond(lbID string, start, end time.Time) (*godo.MetricsResponse, error)
+	GetLoadBalancerFrontendHttpResponses(lbID string, start, end time.Time) (*godo.MetricsResponse, error)
+	GetLoadBalancerDropletsHttpResponseTimeAvg(lbID string, start, end time.Time) (*godo.MetricsResponse, error)
+	GetLoadBalancerDropletsHttpResponseTime95P(lbID string, start, end time.Time) (*godo.MetricsResponse, error)
+	GetDropletBandwidth(dropletID, iface, direction string, start, end time.Time) (*godo.MetricsResponse, error)
+	GetDropletCPU(dropletID string, start, end time.Time) (*godo.MetricsResponse, error)
+	GetAppMetrics(appID, component, metric string, start, end time.Time) (*godo.MetricsResponse, error)
+	GetDropletTotalMemory(dropletID string, start, end time.Time) (*godo.MetricsResponse, error)
+	GetDropletFreeMemory(dropletID string, start, end time.Time) (*godo.MetricsResponse, error)
+	GetDropletCachedMemory(dropletID string, start, end time.Time) (*godo.MetricsResponse, error)
+	GetDropletAvailableMemory(dropletID string, start, end time.Time) (*godo.MetricsResponse, error)
 }
 
 type monitoringService struct {
@@ -109,3 +129,173 @@ func (ms *monitoringService) DeleteAlertPolicy(uuid string) error {
 	_, err := ms.client.Monitoring.DeleteAlertPolicy(context.TODO(), uuid)
 	return err
 }
+
+func (ms *monitoringService) GetLoadBalancerDropletsHealthChecks(lbID string, start, end time.Time) (*godo.MetricsResponse, error) {
+	resp, _, err := ms.client.Monitoring.GetLoadBalancerDropletsHealthChecks(context.TODO(), &godo.LoadBalancerMetricsRequest{
+		LoadBalancerID: lbID,
+		Start:          start,
+		End:            end,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (ms *monitoringService) GetLoadBalancerFrontendHttpRequestsPerSecond(lbID string, start, end time.Time) (*godo.MetricsResponse, error) {
+	resp, _, err := ms.client.Monitoring.GetLoadBalancerFrontendHttpRequestsPerSecond(context.TODO(), &godo.LoadBalancerMetricsRequest{
+		LoadBalancerID: lbID,
+		Start:          start,
+		End:            end,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (ms *monitoringService) GetLoadBalancerFrontendHttpResponses(lbID string, start, end time.Time) (*godo.MetricsResponse, error) {
+	resp, _, err := ms.client.Monitoring.GetLoadBalancerFrontendHttpResponses(context.TODO(), &godo.LoadBalancerMetricsRequest{
+		LoadBalancerID: lbID,
+		Start:          start,
+		End:            end,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (ms *monitoringService) GetLoadBalancerDropletsHttpResponseTimeAvg(lbID string, start, end time.Time) (*godo.MetricsResponse, error) {
+	resp, _, err := ms.client.Monitoring.GetLoadBalancerDropletsHttpResponseTimeAvg(context.TODO(), &godo.LoadBalancerMetricsRequest{
+		LoadBalancerID: lbID,
+		Start:          start,
+		End:            end,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (ms *monitoringService) GetLoadBalancerDropletsHttpResponseTime95P(lbID string, start, end time.Time) (*godo.MetricsResponse, error) {
+	resp, _, err := ms.client.Monitoring.GetLoadBalancerDropletsHttpResponseTime95P(context.TODO(), &godo.LoadBalancerMetricsRequest{
+		LoadBalancerID: lbID,
+		Start:          start,
+		End:            end,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (ms *monitoringService) GetDropletBandwidth(dropletID, iface, direction string, start, end time.Time) (*godo.MetricsResponse, error) {
+	resp, _, err := ms.client.Monitoring.GetDropletBandwidth(context.TODO(), &godo.DropletBandwidthMetricsRequest{
+		DropletMetricsRequest: godo.DropletMetricsRequest{
+			HostID: dropletID,
+			Start:  start,
+			End:    end,
+		},
+		Interface: iface,
+		Direction: direction,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (ms *monitoringService) GetDropletCPU(dropletID string, start, end time.Time) (*godo.MetricsResponse, error) {
+	resp, _, err := ms.client.Monitoring.GetDropletCPU(context.TODO(), &godo.DropletMetricsRequest{
+		HostID: dropletID,
+		Start:  start,
+		End:    end,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (ms *monitoringService) GetDropletTotalMemory(dropletID string, start, end time.Time) (*godo.MetricsResponse, error) {
+	resp, _, err := ms.client.Monitoring.GetDropletTotalMemory(context.TODO(), &godo.DropletMetricsRequest{
+		HostID: dropletID,
+		Start:  start,
+		End:    end,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (ms *monitoringService) GetDropletFreeMemory(dropletID string, start, end time.Time) (*godo.MetricsResponse, error) {
+	resp, _, err := ms.client.Monitoring.GetDropletFreeMemory(context.TODO(), &godo.DropletMetricsRequest{
+		HostID: dropletID,
+		Start:  start,
+		End:    end,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (ms *monitoringService) GetDropletCachedMemory(dropletID string, start, end time.Time) (*godo.MetricsResponse, error) {
+	resp, _, err := ms.client.Monitoring.GetDropletCachedMemory(context.TODO(), &godo.DropletMetricsRequest{
+		HostID: dropletID,
+		Start:  start,
+		End:    end,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (ms *monitoringService) GetDropletAvailableMemory(dropletID string, start, end time.Time) (*godo.MetricsResponse, error) {
+	resp, _, err := ms.client.Monitoring.GetDropletAvailableMemory(context.TODO(), &godo.DropletMetricsRequest{
+		HostID: dropletID,
+		Start:  start,
+		End:    end,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetAppMetrics retrieves an App Platform component metric, such as
+// "cpu_percentage", "memory_percentage", or "restart_count".
+func (ms *monitoringService) GetAppMetrics(appID, component, metric string, start, end time.Time) (*godo.MetricsResponse, error) {
+	req, err := ms.client.NewRequest(context.TODO(), http.MethodGet, fmt.Sprintf(appMetricsPath, metric), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	q := req.URL.Query()
+	q.Add("app_id", appID)
+	q.Add("app_component", component)
+	q.Add("start", strconv.FormatInt(start.Unix(), 10))
+	q.Add("end", strconv.FormatInt(end.Unix(), 10))
+	req.URL.RawQuery = q.Encode()
+
+	root := new(godo.MetricsResponse)
+	if _, err := ms.client.Do(context.TODO(), req, root); err != nil {
+		return nil, err
+	}
+
+	return root, nil
+}
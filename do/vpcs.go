@@ -35,6 +35,14 @@ type VPCPeering struct {
 // VPCPeerings is a slice of VPCPeering
 type VPCPeerings []VPCPeering
 
+// VPCMember wraps a godo VPCMember.
+type VPCMember struct {
+	*godo.VPCMember
+}
+
+// VPCMembers is a slice of VPCMember.
+type VPCMembers []VPCMember
+
 // VPCsService is the godo VPCsService interface.
 type VPCsService interface {
 	Get(vpcUUID string) (*VPC, error)
@@ -43,6 +51,7 @@ type VPCsService interface {
 	Update(vpcUUID string, vpcr *godo.VPCUpdateRequest) (*VPC, error)
 	PartialUpdate(vpcUUID string, options ...godo.VPCSetField) (*VPC, error)
 	Delete(vpcUUID string) error
+	ListMembers(vpcUUID string, resourceType string) (VPCMembers, error)
 	GetPeering(peeringID string) (*VPCPeering, error)
 	ListVPCPeerings() (VPCPeerings, error)
 	CreateVPCPeering(req *godo.VPCPeeringCreateRequest) (*VPCPeering, error)
@@ -134,6 +143,36 @@ func (v *vpcsService) Delete(vpcUUID string) error {
 	return err
 }
 
+func (v *vpcsService) ListMembers(vpcUUID string, resourceType string) (VPCMembers, error) {
+	req := &godo.VPCListMembersRequest{ResourceType: resourceType}
+	f := func(opt *godo.ListOptions) ([]any, *godo.Response, error) {
+		list, resp, err := v.client.VPCs.ListMembers(context.TODO(), vpcUUID, req, opt)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		si := make([]any, len(list))
+		for i := range list {
+			si[i] = list[i]
+		}
+
+		return si, resp, err
+	}
+
+	si, err := PaginateResp(f)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]VPCMember, len(si))
+	for i := range si {
+		a := si[i].(*godo.VPCMember)
+		list[i] = VPCMember{VPCMember: a}
+	}
+
+	return list, nil
+}
+
 func (v *vpcsService) GetPeering(peeringID string) (*VPCPeering, error) {
 	peering, _, err := v.client.VPCs.GetVPCPeering(context.TODO(), peeringID)
 	if err != nil {
@@ -94,6 +94,33 @@ services:
 		expect.Equal(expectedOutput, strings.TrimSpace(string(output)))
 	})
 
+	it("gets an app's spec as json", func() {
+		cmd := exec.Command(builtBinaryPath,
+			"-t", "some-magic-token",
+			"-u", server.URL,
+			"apps", "spec", "get",
+			"--format", "json",
+			testAppUUID,
+		)
+
+		output, err := cmd.CombinedOutput()
+		expect.NoError(err)
+
+		expectedOutput := `{
+  "name": "test",
+  "services": [
+    {
+      "name": "service",
+      "github": {
+        "repo": "digitalocean/doctl",
+        "branch": "main"
+      }
+    }
+  ]
+}`
+		expect.Equal(expectedOutput, strings.TrimSpace(string(output)))
+	})
+
 	it("gets a deployment's spec", func() {
 		cmd := exec.Command(builtBinaryPath,
 			"-t", "some-magic-token",
@@ -178,6 +178,17 @@ var _ = suite("apps/create", func(t *testing.T, when spec.G, it spec.S) {
 			w.Header().Add("content-type", "application/json")
 
 			switch req.URL.Path {
+			case "/v2/apps/propose":
+				auth := req.Header.Get("Authorization")
+				if auth != "Bearer some-magic-token" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				if req.Method != http.MethodPost {
+					w.WriteHeader(http.StatusMethodNotAllowed)
+					return
+				}
+				json.NewEncoder(w).Encode(&godo.AppProposeResponse{Spec: &testAppSpec})
 			case "/v2/apps":
 				auth := req.Header.Get("Authorization")
 				if auth != "Bearer some-magic-token" {
@@ -333,6 +344,17 @@ var _ = suite("apps/create-upsert", func(t *testing.T, when spec.G, it spec.S) {
 			w.Header().Add("content-type", "application/json")
 
 			switch req.URL.Path {
+			case "/v2/apps/propose":
+				auth := req.Header.Get("Authorization")
+				if auth != "Bearer some-magic-token" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				if req.Method != http.MethodPost {
+					w.WriteHeader(http.StatusMethodNotAllowed)
+					return
+				}
+				json.NewEncoder(w).Encode(&godo.AppProposeResponse{Spec: &testAppSpec})
 			case "/v2/apps/" + testAppUUID:
 				auth := req.Header.Get("Authorization")
 				if auth != "Bearer some-magic-token" {
@@ -1210,6 +1232,28 @@ yes                    yes           3 of 3 free, 2 paid    20.00      n/a
 		expect.Equal(expectedOutput, strings.TrimSpace(string(output)))
 	})
 
+	it("omits the cost estimate when --show-cost=false is passed", func() {
+		cmd := exec.Command(builtBinaryPath,
+			"-t", "some-magic-token",
+			"-u", server.URL,
+			"apps", "propose",
+			"--spec", "-",
+			"--app", testAppUUID2,
+			"--show-cost=false",
+		)
+		byt, err := json.Marshal(testAppSpec)
+		expect.NoError(err)
+
+		cmd.Stdin = bytes.NewReader(byt)
+
+		output, err := cmd.CombinedOutput()
+		expect.NoError(err)
+
+		expectedOutput := `App Name Available?    Is Static?    Static App Usage
+yes                    yes           3 of 3 free, 2 paid`
+		expect.Equal(expectedOutput, strings.TrimSpace(string(output)))
+	})
+
 	it("fails on invalid specs", func() {
 		cmd := exec.Command(builtBinaryPath,
 			"-t", "some-magic-token",
@@ -82,9 +82,9 @@ var _ = suite("database/list", func(t *testing.T, when spec.G, it spec.S) {
 
 const (
 	databaseListOutput = `
-ID                                      Name                  Engine    Version    Number of Nodes    Region    Status    Size              Storage (MiB)
-1df3bb24-d2a5-11ef-ae5c-ff567b29ed0b    db-mysql-test         mysql     8          1                  nyc3      online    db-s-1vcpu-1gb    10240
-6ee6ac26-d2a5-11ef-b1f3-d74f7709225a    db-postgresql-test    pg        16         1                  nyc3      online    db-s-1vcpu-1gb    10240
+ID                                      Name                  Engine    Version    Number of Nodes    High Availability    Region    Status    Size              Storage (MiB)    Maintenance Day    Maintenance Hour
+1df3bb24-d2a5-11ef-ae5c-ff567b29ed0b    db-mysql-test         mysql     8          1                  false                nyc3      online    db-s-1vcpu-1gb    10240            monday             10:23:13
+6ee6ac26-d2a5-11ef-b1f3-d74f7709225a    db-postgresql-test    pg        16         1                  false                nyc3      online    db-s-1vcpu-1gb    10240            saturday           14:00:00
 `
 	databaseFormattedListOutput = `
 ID                                      Name                  URI                                                                                                                    Created At
@@ -37,6 +37,10 @@ var _ = suite("compute/domain/list", func(t *testing.T, when spec.G, it spec.S)
 				}
 
 				w.Write([]byte(domainListResponse))
+			case "/v2/domains/example.com/records":
+				w.Write([]byte(domainListRecordsResponse))
+			case "/v2/domains/exmaple.com/records":
+				w.Write([]byte(`{"domain_records": [], "meta": {"total": 0}}`))
 			default:
 				dump, err := httputil.DumpRequest(req, true)
 				if err != nil {
@@ -75,6 +79,16 @@ var _ = suite("compute/domain/list", func(t *testing.T, when spec.G, it spec.S)
 			expect.Equal(strings.TrimSpace(domainListOutput), strings.TrimSpace(string(output)))
 		})
 	})
+
+	when("passing the with-details flag", func() {
+		it("lists all domains with record count and name servers", func() {
+			cmd.Args = append(cmd.Args, []string{"list", "--with-details"}...)
+
+			output, err := cmd.CombinedOutput()
+			expect.NoError(err, fmt.Sprintf("received error output: %s", output))
+			expect.Equal(strings.TrimSpace(domainListWithDetailsOutput), strings.TrimSpace(string(output)))
+		})
+	})
 })
 
 const (
@@ -82,6 +96,23 @@ const (
 Domain         TTL
 example.com    1800
 exmaple.com    1800
+`
+	domainListWithDetailsOutput = `
+Domain         TTL     Record Count    NS Servers
+example.com    1800    3               ns1.digitalocean.com,ns2.digitalocean.com
+exmaple.com    1800    0               
+`
+	domainListRecordsResponse = `
+{
+  "domain_records": [
+    {"id": 1, "type": "NS", "name": "@", "data": "ns1.digitalocean.com", "ttl": 1800},
+    {"id": 2, "type": "NS", "name": "@", "data": "ns2.digitalocean.com", "ttl": 1800},
+    {"id": 3, "type": "A", "name": "@", "data": "127.0.0.1", "ttl": 1800}
+  ],
+  "meta": {
+    "total": 3
+  }
+}
 `
 	domainListResponse = `
 {
@@ -69,6 +69,8 @@ var _ = suite("compute/droplet/create", func(t *testing.T, when spec.G, it spec.
 				// since we've successfully tested all the behavior
 				// at this point
 				w.Write([]byte(dropletCreateResponse))
+			case "/v2/vpcs/00000000-0000-4000-8000-000000000000":
+				w.Write([]byte(`{"vpc": {"id": "00000000-0000-4000-8000-000000000000", "region": "a-test-region"}}`))
 			case "/v2/projects/00000000-0000-4000-8000-000000000000":
 				w.WriteHeader(http.StatusOK)
 				w.Write([]byte(getProjectResponse))
@@ -88,6 +88,6 @@ const firewallListResponse = `{
 }`
 
 const firewallListOutput = `
-ID                                      Name             Status       Created At              Inbound Rules              Outbound Rules    Droplet IDs    Tags    Pending Changes
-e4b9c960-d385-4950-84f3-d102162e6be5    test-firewall    succeeded    2019-10-24T20:30:26Z    protocol:tcp,ports:443,
+ID                                      Name             Status       Created At              Inbound Rule Count    Outbound Rule Count    Inbound Rules              Outbound Rules    Droplet IDs    Droplets Count    Tags    Pending Changes
+e4b9c960-d385-4950-84f3-d102162e6be5    test-firewall    succeeded    2019-10-24T20:30:26Z    1                     0                      protocol:tcp,ports:443,                                     0
 `
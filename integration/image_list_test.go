@@ -78,6 +78,41 @@ var _ = suite("compute/image/list", func(t *testing.T, when spec.G, it spec.S) {
 		})
 	})
 
+	when("passing public-only flag", func() {
+		it("lists public images", func() {
+			cmd := exec.Command(builtBinaryPath,
+				"-t", "some-magic-token",
+				"-u", server.URL,
+				"compute",
+				"image",
+				"list",
+				"--public-only",
+			)
+
+			output, err := cmd.CombinedOutput()
+			expect.NoError(err, fmt.Sprintf("received error output: %s", output))
+			expect.Equal(strings.TrimSpace(imageListPublicOutput), strings.TrimSpace(string(output)))
+		})
+	})
+
+	when("passing public and private flags", func() {
+		it("lists private images", func() {
+			cmd := exec.Command(builtBinaryPath,
+				"-t", "some-magic-token",
+				"-u", server.URL,
+				"compute",
+				"image",
+				"list",
+				"--public",
+				"--private",
+			)
+
+			output, err := cmd.CombinedOutput()
+			expect.NoError(err, fmt.Sprintf("received error output: %s", output))
+			expect.Equal(strings.TrimSpace(imageListPrivateOutput), strings.TrimSpace(string(output)))
+		})
+	})
+
 	when("passing no flags", func() {
 		it("lists private images", func() {
 			cmd := exec.Command(builtBinaryPath,
@@ -150,6 +185,10 @@ var _ = suite("compute/image/list", func(t *testing.T, when spec.G, it spec.S) {
 })
 
 const (
+	imageListPublicOutput = `
+ID         Name                                        Type    Distribution    Slug             Public    Min Disk    Created
+6376601    Ruby on Rails on 14.04 (Nginx + Unicorn)            Ubuntu          ruby-on-rails    true      20          2014-09-26T20:20:24Z
+`
 	imageListPrivateOutput = `
 ID         Name                                        Type    Distribution    Slug             Public    Min Disk    Created
 6376602    Ruby on Rails on 14.04 (Nginx + Unicorn)            Ubuntu          ruby-on-rails    false     20          2014-09-26T20:20:24Z
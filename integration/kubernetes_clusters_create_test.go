@@ -215,8 +215,8 @@ Notice: Cluster created, fetching credentials
 Notice: Adding cluster credentials to kubeconfig file found in %q
 Notice: Setting current-context to some-context
 Notice: Some response message
-ID                 Name                 Region    Version              Auto Upgrade    Status     Node Pools
-some-cluster-id    some-cluster-name    mars      some-kube-version    false           running    frontend-pool
+ID                 Name                 Region    Version              Auto Upgrade    Status     Node Pools       Node Count
+some-cluster-id    some-cluster-name    mars      some-kube-version    false           running    frontend-pool    0
 `
 	kubeClustersCreateJSONReq = `
 {
@@ -128,8 +128,8 @@ var (
 `
 
 	k8sListOutput = `
-ID                 Name                 Region    Version              Auto Upgrade    Status     Node Pools
-some-cluster-id    some-cluster-name    nyc3      some-kube-version    true            running    frontend-pool
+ID                 Name                 Region    Version              Auto Upgrade    Status     Node Pools       Node Count
+some-cluster-id    some-cluster-name    nyc3      some-kube-version    true            running    frontend-pool    0
 `
 
 	k8sListFormattedOutput = `
@@ -41,9 +41,31 @@ var _ = suite("compute/volume/create", func(t *testing.T, when spec.G, it spec.S
 				reqBody, err := io.ReadAll(req.Body)
 				expect.NoError(err)
 
-				expect.JSONEq(volumeCreateRequest, string(reqBody))
+				if strings.Contains(string(reqBody), "volume-snapshot-id") {
+					expect.JSONEq(volumeCreateFromSnapshotRequest, string(reqBody))
+				} else {
+					expect.JSONEq(volumeCreateRequest, string(reqBody))
+				}
 
 				w.Write([]byte(volumeCreateResponse))
+			case "/v2/snapshots/volume-snapshot-id", "/v2/snapshots/droplet-snapshot-id":
+				auth := req.Header.Get("Authorization")
+				if auth != "Bearer some-magic-token" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+
+				if req.Method != http.MethodGet {
+					w.WriteHeader(http.StatusMethodNotAllowed)
+					return
+				}
+
+				if strings.HasSuffix(req.URL.Path, "droplet-snapshot-id") {
+					w.Write([]byte(volumeCreateDropletSnapshotResponse))
+					return
+				}
+
+				w.Write([]byte(volumeCreateSnapshotResponse))
 			default:
 				dump, err := httputil.DumpRequest(req, true)
 				if err != nil {
@@ -81,6 +103,44 @@ var _ = suite("compute/volume/create", func(t *testing.T, when spec.G, it spec.S
 			}
 		})
 	})
+
+	when("passing a volume snapshot id", func() {
+		it("creates the volume from the snapshot", func() {
+			cmd = exec.Command(builtBinaryPath,
+				"-t", "some-magic-token",
+				"-u", server.URL,
+				"compute",
+				"volume",
+				"create",
+				"my-volume",
+				"--size", "4TiB",
+				"--snapshot-id", "volume-snapshot-id",
+			)
+
+			output, err := cmd.CombinedOutput()
+			expect.NoError(err, fmt.Sprintf("received error output: %s", output))
+			expect.Equal(strings.TrimSpace(volumeCreateOutput), strings.TrimSpace(string(output)))
+		})
+	})
+
+	when("passing a droplet snapshot id", func() {
+		it("returns an error", func() {
+			cmd = exec.Command(builtBinaryPath,
+				"-t", "some-magic-token",
+				"-u", server.URL,
+				"compute",
+				"volume",
+				"create",
+				"my-volume",
+				"--size", "4TiB",
+				"--snapshot-id", "droplet-snapshot-id",
+			)
+
+			output, err := cmd.CombinedOutput()
+			expect.Error(err)
+			expect.Equal(`Error: snapshot "droplet-snapshot-id" is a droplet snapshot; only volume snapshots can be used to create a volume`, strings.TrimSpace(string(output)))
+		})
+	})
 })
 
 const (
@@ -128,5 +188,42 @@ some-generated-id    my-volume    4000 GiB    mars1     xfs                some-
   "filesystem_type":"xfs",
   "filesystem_label":"some-fs-label",
   "tags":["yes","again"]
+}`
+	volumeCreateFromSnapshotRequest = `
+{
+  "region":"",
+  "name": "my-volume",
+  "description":"",
+  "size_gigabytes":4096,
+  "snapshot_id":"volume-snapshot-id",
+  "filesystem_type":"",
+  "filesystem_label":"",
+  "tags":[]
+}`
+	volumeCreateSnapshotResponse = `
+{
+  "snapshot": {
+    "id": "volume-snapshot-id",
+    "name": "my-volume-snapshot",
+    "regions": ["mars1"],
+    "created_at": "2016-03-02T17:00:49Z",
+    "resource_id": "some-volume-id",
+    "resource_type": "volume",
+    "min_disk_size": 4000,
+    "size_gigabytes": 4000
+  }
+}`
+	volumeCreateDropletSnapshotResponse = `
+{
+  "snapshot": {
+    "id": "droplet-snapshot-id",
+    "name": "my-droplet-snapshot",
+    "regions": ["mars1"],
+    "created_at": "2016-03-02T17:00:49Z",
+    "resource_id": "1234",
+    "resource_type": "droplet",
+    "min_disk_size": 25,
+    "size_gigabytes": 2.5
+  }
 }`
 )
@@ -90,6 +90,25 @@ var _ = suite("vpcs/create", func(t *testing.T, when spec.G, it spec.S) {
 		})
 	})
 
+	when("the ID column is requested without headers", func() {
+		it("prints only the ID of the new VPC", func() {
+			cmd := exec.Command(builtBinaryPath,
+				"-t", "some-magic-token",
+				"-u", server.URL,
+				"vpcs",
+				"create",
+				"--name", "some-vpc",
+				"--region", "nyc3",
+				"--format", "id",
+				"--no-header",
+			)
+
+			output, err := cmd.CombinedOutput()
+			expect.NoError(err, fmt.Sprintf("received error output: %s", output))
+			expect.Equal("5a4981aa-9653-4bd1-bef5-d6bff52042e4\n", string(output))
+		})
+	})
+
 	when("missing required arguments", func() {
 		base := []string{
 			"-t", "some-magic-token",